@@ -1,7 +1,9 @@
 package codecompletion
 
 import (
+	"math"
 	"slices"
+	"sort"
 	"strings"
 
 	"github.com/inoxlang/inox/internal/core"
@@ -16,6 +18,10 @@ import (
 	parse "github.com/inoxlang/inox/internal/parse"
 )
 
+const (
+	MAX_FUZZY_COMPLETION_DISTANCE = 2
+)
+
 var (
 	CONTEXT_INDEPENDENT_STMT_STARTING_KEYWORDS                   = []string{"if", "drop-perms", "for", "assign", "switch", "match", "return", "assert"}
 	GLOBALNAMES_WITHOUT_IDENT_CONVERSION_TO_VAR_IN_CMD_LIKE_CALL = []string{globalnames.HELP_FN}
@@ -38,6 +44,10 @@ type Mode int
 const (
 	ShellCompletions Mode = iota
 	LspCompletions
+
+	// HoverInfo makes FindCompletions return a single completion describing the node at the cursor:
+	// its symbolic type and its documentation if any.
+	HoverInfo
 )
 
 func (m Mode) String() string {
@@ -46,6 +56,8 @@ func (m Mode) String() string {
 		return "shell-completions"
 	case LspCompletions:
 		return "LSP-completions"
+	case HoverInfo:
+		return "hover-info"
 	default:
 		panic(core.ErrUnreachable)
 	}
@@ -57,6 +69,10 @@ type SearchArgs struct {
 	CursorIndex int
 	Mode        Mode
 	InputData   InputData
+
+	//if true the variables whose name is close to the typed name (small edit distance) are suggested in addition
+	//to the ones whose name starts with it, the completions are then sorted by relevance.
+	Fuzzy bool
 }
 
 func FindCompletions(args SearchArgs) []Completion {
@@ -105,9 +121,14 @@ func FindCompletions(args SearchArgs) []Completion {
 		parent:        parent,
 		ancestorChain: ancestors,
 		inputData:     args.InputData,
+		fuzzy:         args.Fuzzy,
 	}
 
-	if isCursorInsideOrAtEndOfComment {
+	if mode == HoverInfo {
+		if !isCursorInsideOrAtEndOfComment {
+			completions = findHoverInfo(nodeAtCursor, search)
+		}
+	} else if isCursorInsideOrAtEndOfComment {
 		completions = handleCompletionInsideComment()
 	} else {
 		switch n := nodeAtCursor.(type) {
@@ -161,11 +182,19 @@ func FindCompletions(args SearchArgs) []Completion {
 			}
 		case *parse.XMLElement:
 			completions = findHyperscriptScriptCompletions(n, search)
+		case *parse.MissingExpression:
+			if closingElem, ok := parent.(*parse.XMLClosingElement); ok {
+				completions = findXMLClosingTagCompletions(closingElem, search)
+			}
 		case *parse.HyperscriptAttributeShorthand:
 			completions = findHyperscriptAttributeCompletions(n, search)
 		}
 	}
 
+	if args.Fuzzy {
+		sortCompletionsByRelevance(state.Global.Ctx, completions, nodeAtCursor)
+	}
+
 	//Set unitialized .ReplacedRange fields of completions.
 
 	for i, completion := range completions {
@@ -190,6 +219,74 @@ type completionSearch struct {
 	parent        parse.Node
 	ancestorChain []parse.Node
 	inputData     InputData
+	fuzzy         bool
+}
+
+// matchesTypedName returns true if name starts with the typed name, or if the search is fuzzy and name is close to it.
+func (search completionSearch) matchesTypedName(name, typed string) bool {
+	if hasPrefixCaseInsensitive(name, typed) {
+		return true
+	}
+	if !search.fuzzy || typed == "" {
+		return false
+	}
+	_, ok := fuzzyDistance(search.state.Global.Ctx, name, typed)
+	return ok
+}
+
+// fuzzyDistance returns the case-insensitive edit distance between name and the typed name, ok is false if
+// the distance is too large for name to be suggested.
+func fuzzyDistance(ctx *core.Context, name, typed string) (distance int, ok bool) {
+	_, distance, ok = utils.FindClosestString(ctx, []string{strings.ToLower(name)}, strings.ToLower(typed), MAX_FUZZY_COMPLETION_DISTANCE)
+	return
+}
+
+// sortCompletionsByRelevance sorts completions for an identifier or a variable: completions starting with
+// the typed name come first, the other ones are sorted by increasing edit distance.
+func sortCompletionsByRelevance(ctx *core.Context, completions []Completion, nodeAtCursor parse.Node) {
+	var typed string
+
+	switch n := nodeAtCursor.(type) {
+	case *parse.IdentifierLiteral:
+		typed = n.Name
+	case *parse.Variable:
+		typed = n.Name
+	case *parse.GlobalVariable:
+		typed = n.Name
+	default:
+		return
+	}
+
+	relevanceRank := func(completion Completion) int {
+		name := strings.TrimLeft(completion.ShownString, "$")
+		if hasPrefixCaseInsensitive(name, typed) {
+			return 0
+		}
+		distance, ok := fuzzyDistance(ctx, name, typed)
+		if !ok {
+			return math.MaxInt
+		}
+		return 1 + distance
+	}
+
+	//the rank of each completion is computed once because computing an edit distance is costly.
+	type rankedCompletion struct {
+		completion Completion
+		rank       int
+	}
+
+	rankedCompletions := make([]rankedCompletion, len(completions))
+	for i, completion := range completions {
+		rankedCompletions[i] = rankedCompletion{completion: completion, rank: relevanceRank(completion)}
+	}
+
+	sort.SliceStable(rankedCompletions, func(i, j int) bool {
+		return rankedCompletions[i].rank < rankedCompletions[j].rank
+	})
+
+	for i, ranked := range rankedCompletions {
+		completions[i] = ranked.completion
+	}
 }
 
 func handlePatternIdentCompletions(n *parse.PatternIdentifierLiteral, search completionSearch) []Completion {
@@ -202,10 +299,14 @@ func handlePatternIdentCompletions(n *parse.PatternIdentifierLiteral, search com
 	var completions []Completion
 
 	if mode == ShellCompletions {
-		for name, patt := range state.Global.Ctx.GetNamedPatterns() {
+		for _, name := range state.Global.Ctx.AllNamedPatternNamesSorted() {
 			if !hasPrefixCaseInsensitive(name, n.Name) {
 				continue
 			}
+			patt := ctx.ResolveNamedPattern(name)
+			if patt == nil {
+				continue
+			}
 			detail, _ := core.GetStringifiedSymbolicValue(ctx, patt, false)
 
 			hasPercent := parse.GetFirstTokenString(n, chunk.Node)[0] == '%'
@@ -243,6 +344,23 @@ func handlePatternIdentCompletions(n *parse.PatternIdentifierLiteral, search com
 		}
 	} else {
 		contextData, _ := state.Global.SymbolicData.GetContextData(n, ancestorChain)
+
+		//if the pattern is the type of a variable declaration we get the value of the initializer
+		//in order to suggest the compatible patterns first.
+		var initialValue symbolic.Value
+		switch decl := search.parent.(type) {
+		case *parse.LocalVariableDeclaration:
+			if decl.Type == n && decl.Right != nil {
+				initialValue, _ = state.Global.SymbolicData.GetMostSpecificNodeValue(decl.Right)
+			}
+		case *parse.GlobalVariableDeclaration:
+			if decl.Type == n && decl.Right != nil {
+				initialValue, _ = state.Global.SymbolicData.GetMostSpecificNodeValue(decl.Right)
+			}
+		}
+
+		var otherPatternCompletions []Completion
+
 		for _, patternData := range contextData.Patterns {
 			if !hasPrefixCaseInsensitive(patternData.Name, n.Name) {
 				continue
@@ -252,13 +370,21 @@ func handlePatternIdentCompletions(n *parse.PatternIdentifierLiteral, search com
 			if !n.Unprefixed {
 				s = "%" + s
 			}
-			completions = append(completions, Completion{
+			completion := Completion{
 				ShownString: s,
 				Value:       s,
 				Kind:        defines.CompletionItemKindInterface,
 				LabelDetail: symbolic.Stringify(patternData.Value),
-			})
+			}
+
+			if initialValue != nil && !patternData.Value.TestValue(initialValue, symbolic.RecTestCallState{}) {
+				otherPatternCompletions = append(otherPatternCompletions, completion)
+			} else {
+				completions = append(completions, completion)
+			}
 		}
+		completions = append(completions, otherPatternCompletions...)
+
 		for _, namespaceData := range contextData.PatternNamespaces {
 			if !hasPrefixCaseInsensitive(namespaceData.Name, n.Name) {
 				continue
@@ -375,7 +501,7 @@ func handleLocalVariableCompletions(n *parse.Variable, search completionSearch)
 	if mode == ShellCompletions {
 		for name, varVal := range state.CurrentLocalScope() {
 
-			if hasPrefixCaseInsensitive(name, n.Name) {
+			if search.matchesTypedName(name, n.Name) {
 				names = append(names, name)
 
 				detail, _ := core.GetStringifiedSymbolicValue(ctx, varVal, false)
@@ -385,7 +511,7 @@ func handleLocalVariableCompletions(n *parse.Variable, search completionSearch)
 	} else {
 		scopeData, _ := state.Global.SymbolicData.GetLocalScopeData(n, ancestorChain)
 		for _, varData := range scopeData.Variables {
-			if hasPrefixCaseInsensitive(varData.Name, n.Name) {
+			if search.matchesTypedName(varData.Name, n.Name) {
 				names = append(names, varData.Name)
 
 				labelDetails = append(labelDetails, symbolic.Stringify(varData.Value))
@@ -414,7 +540,7 @@ func handleGlobalVariableCompletions(n *parse.GlobalVariable, search completionS
 
 	if mode == ShellCompletions {
 		state.Global.Globals.Foreach(func(name string, varVal core.Value, _ bool) error {
-			if hasPrefixCaseInsensitive(name, n.Name) {
+			if search.matchesTypedName(name, n.Name) {
 				detail, _ := core.GetStringifiedSymbolicValue(ctx, varVal, false)
 				completions = append(completions, Completion{
 					ShownString: name,
@@ -429,7 +555,7 @@ func handleGlobalVariableCompletions(n *parse.GlobalVariable, search completionS
 		scopeData, _ := state.Global.SymbolicData.GetGlobalScopeData(n, ancestorChain)
 
 		for _, varData := range scopeData.Variables {
-			if hasPrefixCaseInsensitive(varData.Name, n.Name) {
+			if search.matchesTypedName(varData.Name, n.Name) {
 				completions = append(completions, Completion{
 					ShownString: varData.Name,
 					Value:       "$$" + varData.Name,
@@ -479,14 +605,14 @@ func handleIdentifierAndKeywordCompletions(ident *parse.IdentifierLiteral, deepe
 
 			completionSet := make(map[Completion]bool)
 
-			for _, perm := range state.Global.Ctx.GetGrantedPermissions() {
+			state.Global.Ctx.ForEachGrantedPermission(func(perm core.Permission) bool {
 				cmdPerm, ok := perm.(core.CommandPermission)
 				if !ok ||
 					cmdPerm.CommandName.UnderlyingString() != calleeIdent.Name ||
 					len(subcommandIdentChain) > len(cmdPerm.SubcommandNameChain) ||
 					len(cmdPerm.SubcommandNameChain) == 0 ||
 					!strings.HasPrefix(cmdPerm.SubcommandNameChain[argIndex], ident.Name) {
-					continue
+					return true
 				}
 
 				subcommandName := cmdPerm.SubcommandNameChain[argIndex]
@@ -500,7 +626,8 @@ func handleIdentifierAndKeywordCompletions(ident *parse.IdentifierLiteral, deepe
 					completions = append(completions, completion)
 					completionSet[completion] = true
 				}
-			}
+				return true
+			})
 		}
 	}
 
@@ -771,6 +898,8 @@ after_subcommand_completions:
 			completions = findXmlTagAndTagNameCompletions(ident, search)
 		}
 		return completions
+	case *parse.XMLClosingElement:
+		return findXMLClosingTagCompletions(p, search)
 	}
 
 	callExpr, ok := parent.(*parse.CallExpression)
@@ -781,7 +910,7 @@ after_subcommand_completions:
 	//suggest local variables
 	if mode == ShellCompletions {
 		for name, varVal := range state.CurrentLocalScope() {
-			if hasPrefixCaseInsensitive(name, ident.Name) {
+			if search.matchesTypedName(name, ident.Name) {
 				detail, _ := core.GetStringifiedSymbolicValue(state.Global.Ctx, varVal, false)
 
 				if isCommandLikeCallArgument {
@@ -799,7 +928,7 @@ after_subcommand_completions:
 	} else {
 		scopeData, _ := state.Global.SymbolicData.GetLocalScopeData(ident, ancestors)
 		for _, varData := range scopeData.Variables {
-			if hasPrefixCaseInsensitive(varData.Name, ident.Name) {
+			if search.matchesTypedName(varData.Name, ident.Name) {
 
 				name := varData.Name
 				if isCommandLikeCallArgument {
@@ -821,7 +950,7 @@ after_subcommand_completions:
 	if mode == ShellCompletions {
 
 		state.Global.Globals.Foreach(func(name string, varVal core.Value, _ bool) error {
-			if hasPrefixCaseInsensitive(name, ident.Name) {
+			if search.matchesTypedName(name, ident.Name) {
 				detail, _ := core.GetStringifiedSymbolicValue(state.Global.Ctx, varVal, false)
 
 				if isCommandLikeCallArgument {
@@ -844,7 +973,7 @@ after_subcommand_completions:
 		scopeData, _ := state.Global.SymbolicData.GetGlobalScopeData(ident, ancestors)
 
 		for _, varData := range scopeData.Variables {
-			if hasPrefixCaseInsensitive(varData.Name, ident.Name) {
+			if search.matchesTypedName(varData.Name, ident.Name) {
 
 				name := varData.Name
 				if isCommandLikeCallArgument {
@@ -926,6 +1055,27 @@ after_subcommand_completions:
 		}
 	}
 
+	//suggest literals matching the expected value
+
+	if expectedValue, ok := getExpectedValue(ident, search); ok && !symbolic.IsAny(expectedValue) {
+		for _, literal := range []struct {
+			text  string
+			value symbolic.Value
+		}{
+			{"true", symbolic.TRUE},
+			{"false", symbolic.FALSE},
+			{"nil", symbolic.Nil},
+		} {
+			if hasPrefixCaseInsensitive(literal.text, ident.Name) && expectedValue.Test(literal.value, symbolic.RecTestCallState{}) {
+				completions = append(completions, Completion{
+					ShownString: literal.text,
+					Value:       literal.text,
+					Kind:        defines.CompletionItemKindConstant,
+				})
+			}
+		}
+	}
+
 	//suggest some expression-starting keywords
 
 	for _, keyword := range []string{"treedata", "Mapping", "concat"} {
@@ -941,6 +1091,52 @@ after_subcommand_completions:
 	return completions
 }
 
+// getExpectedValue determines the value expected at the position of $ident based on its parent node.
+func getExpectedValue(ident *parse.IdentifierLiteral, search completionSearch) (symbolic.Value, bool) {
+	switch parent := search.parent.(type) {
+	case *parse.IfStatement:
+		if parent.Test == ident {
+			return symbolic.ANY_BOOL, true
+		}
+	case *parse.IfExpression:
+		if parent.Test == ident {
+			return symbolic.ANY_BOOL, true
+		}
+	case *parse.CallExpression:
+		if parent.CommandLikeSyntax || search.state.Global.SymbolicData == nil {
+			break
+		}
+
+		argIndex := slices.IndexFunc(parent.Arguments, func(arg parse.Node) bool {
+			return arg == ident
+		})
+		if argIndex < 0 {
+			break
+		}
+
+		callee, ok := search.state.Global.SymbolicData.GetMostSpecificNodeValue(parent.Callee)
+		if !ok {
+			break
+		}
+
+		fn, ok := callee.(*symbolic.InoxFunction)
+		if !ok {
+			break
+		}
+
+		params := fn.Parameters()
+		nonVariadicParamCount := len(params)
+		if fn.IsVariadic() {
+			nonVariadicParamCount--
+		}
+
+		if argIndex < nonVariadicParamCount {
+			return params[argIndex], true
+		}
+	}
+	return nil, false
+}
+
 func handleIdentifierMemberCompletions(n *parse.IdentifierMemberExpression, search completionSearch) []Completion {
 	state := search.state
 	mode := search.mode
@@ -1123,6 +1319,19 @@ loop:
 			if symbolic.IsAnyOrAnySerializable(curr.(symbolic.Value)) {
 				return nil
 			}
+
+			if structType, ok := getPointedStructType(curr); ok {
+				field, ok := structType.FieldByName(propNameNode.Name)
+				if ok {
+					curr = field.Type.SymbolicValue()
+					continue
+				}
+				if i < len(exprPropertyNames)-1 { //if not last
+					return nil
+				}
+				break
+			}
+
 			// if the at one point in the member chain a value has no properties we have no completions to propose
 			// so we just return an empty list
 			iprops, ok := curr.(symbolic.IProps)
@@ -1304,6 +1513,16 @@ func suggestPropertyNames(
 		optionalProps = utils.MapSlice(propNames, func(name string) bool {
 			return symbolic.IsPropertyOptional(v, name)
 		})
+	case *symbolic.Pointer:
+		structType, ok := getPointedStructType(v)
+		if !ok {
+			break
+		}
+		for _, field := range structType.Fields() {
+			propNames = append(propNames, field.Name)
+			propLabelDetails = append(propLabelDetails, symbolic.Stringify(field.Type.SymbolicValue()))
+			markdownDocumentations = append(markdownDocumentations, "")
+		}
 	}
 
 	if !isLastPropPresent {
@@ -1353,6 +1572,16 @@ func suggestPropertyNames(
 	return completions
 }
 
+// getPointedStructType returns the type of the struct pointed to by v if v is a symbolic pointer to a struct.
+func getPointedStructType(v any) (*symbolic.StructType, bool) {
+	ptr, ok := v.(*symbolic.Pointer)
+	if !ok {
+		return nil, false
+	}
+	structType, ok := ptr.ValueType().(*symbolic.StructType)
+	return structType, ok
+}
+
 func handleNewCallArgumentCompletions(n *parse.CallExpression, search completionSearch) []Completion {
 	cursorIndex := search.cursorIndex
 	state := search.state
@@ -1375,14 +1604,13 @@ func handleNewCallArgumentCompletions(n *parse.CallExpression, search completion
 
 	completionSet := make(map[Completion]bool)
 
-top_loop:
-	for _, perm := range state.Global.Ctx.GetGrantedPermissions() {
+	state.Global.Ctx.ForEachGrantedPermission(func(perm core.Permission) bool {
 		cmdPerm, ok := perm.(core.CommandPermission)
 		if !ok ||
 			cmdPerm.CommandName.UnderlyingString() != calleeIdent.Name ||
 			len(subcommandIdentChain) >= len(cmdPerm.SubcommandNameChain) ||
 			len(cmdPerm.SubcommandNameChain) == 0 {
-			continue
+			return true
 		}
 
 		if len(subcommandIdentChain) == 0 {
@@ -1399,7 +1627,7 @@ top_loop:
 				completions = append(completions, completion)
 				completionSet[completion] = true
 			}
-			continue
+			return true
 		}
 
 		holeIndex := -1
@@ -1408,7 +1636,7 @@ top_loop:
 		for i, name := range cmdPerm.SubcommandNameChain {
 			if name != subcommandIdentChain[identIndex].Name {
 				if holeIndex >= 0 {
-					continue top_loop
+					return true
 				}
 				holeIndex = i
 			} else {
@@ -1434,7 +1662,8 @@ top_loop:
 			completions = append(completions, completion)
 			completionSet[completion] = true
 		}
-	}
+		return true
+	})
 	return completions
 }
 
@@ -5,8 +5,10 @@ import (
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/go-git/go-billy/v5/util"
+	"github.com/inoxlang/inox/internal/afs"
 	"github.com/inoxlang/inox/internal/core"
 	"github.com/inoxlang/inox/internal/core/permkind"
 	"github.com/inoxlang/inox/internal/core/symbolic"
@@ -132,6 +134,37 @@ func runSingleModeTests(t *testing.T, mode Mode, wd, dir string) {
 		return _findCompletions(state, chunk, cursorIndex, false, nil)
 	}
 
+	t.Run("hover info", func(t *testing.T) {
+		if mode != LspCompletions {
+			t.Skip()
+			return
+		}
+
+		t.Run("typed variable", func(t *testing.T) {
+			state := core.NewTreeWalkState(core.NewContext(core.ContextConfig{Permissions: perms}))
+			defer state.Global.Ctx.CancelGracefully()
+
+			state.Global.Ctx.AddNamedPattern("int", core.INT_PATTERN)
+
+			chunk, _ := parseChunkSource("fn(val int){val}", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := FindCompletions(SearchArgs{
+				State:       state,
+				Chunk:       chunk,
+				CursorIndex: 14,
+				Mode:        HoverInfo,
+			})
+
+			if !assert.Len(t, completions, 1) {
+				return
+			}
+			assert.Equal(t, "%int", completions[0].ShownString)
+			assert.Equal(t, "%int", completions[0].LabelDetail)
+			assert.Equal(t, parse.NodeSpan{Start: 12, End: 15}, completions[0].ReplacedRange.Span)
+		})
+	})
+
 	t.Run("variables", func(t *testing.T) {
 		if mode != LspCompletions {
 			t.Skip()
@@ -164,6 +197,32 @@ func runSingleModeTests(t *testing.T, mode Mode, wd, dir string) {
 			}, completions)
 		})
 
+		t.Run("local variable in top level module: misspelled name", func(t *testing.T) {
+			state := core.NewTreeWalkState(core.NewContext(core.ContextConfig{Permissions: perms}))
+			defer state.Global.Ctx.CancelGracefully()
+
+			chunk, _ := parseChunkSource("value = 1; vlaue", "")
+
+			doSymbolicCheck(chunk, state.Global)
+
+			completions := findCompletions(state, chunk, 16)
+			assert.Empty(t, completions)
+
+			completions = FindCompletions(SearchArgs{
+				State:       state,
+				Chunk:       chunk,
+				CursorIndex: 16,
+				Mode:        mode,
+				Fuzzy:       true,
+			})
+
+			if !assert.NotEmpty(t, completions) {
+				return
+			}
+			assert.Equal(t, "value", completions[0].ShownString)
+			assert.Equal(t, parse.NodeSpan{Start: 11, End: 16}, completions[0].ReplacedRange.Span)
+		})
+
 		t.Run("local variable within a function", func(t *testing.T) {
 			state := core.NewTreeWalkState(core.NewContext(core.ContextConfig{Permissions: perms}))
 			defer state.Global.Ctx.CancelGracefully()
@@ -462,6 +521,39 @@ func runSingleModeTests(t *testing.T, mode Mode, wd, dir string) {
 			}, completions)
 		})
 
+		t.Run("suggest struct field: empty field name", func(t *testing.T) {
+			if mode == ShellCompletions {
+				t.Skip()
+				return
+			}
+
+			state := newState()
+			chunk, _ := parseChunkSource("struct Position {x int; y int}; ptr = new Position; $ptr.", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 57)
+			assert.EqualValues(t, []Completion{
+				{ShownString: ".x", Value: ".x", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 56, End: 57}}},
+				{ShownString: ".y", Value: ".y", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 56, End: 57}}},
+			}, completions)
+		})
+
+		t.Run("suggest struct field: start of field name", func(t *testing.T) {
+			if mode == ShellCompletions {
+				t.Skip()
+				return
+			}
+
+			state := newState()
+			chunk, _ := parseChunkSource("struct Rect {width int; height int}; ptr = new Rect; $ptr.w", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 59)
+			assert.EqualValues(t, []Completion{
+				{ShownString: ".width", Value: ".width", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 57, End: 59}}},
+			}, completions)
+		})
+
 		t.Run("suggest property of shared object's property", func(t *testing.T) {
 			if mode == ShellCompletions {
 				//TODO: support
@@ -635,6 +727,27 @@ func runSingleModeTests(t *testing.T, mode Mode, wd, dir string) {
 			}, completions)
 		})
 
+		t.Run("suggest patterns matching the initial value of a variable first", func(t *testing.T) {
+			state := newState()
+			state.Global.Ctx.AddNamedPattern("int", core.INT_PATTERN)
+			chunk, _ := parseChunkSource("pattern ident = #a; var x %i = #a", "")
+			doSymbolicCheck(chunk, state.Global)
+
+			completions := findCompletions(state, chunk, 28)
+			assert.EqualValues(t, []Completion{
+				{
+					ShownString:   "%ident",
+					Value:         "%ident",
+					ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 26, End: 28}},
+				},
+				{
+					ShownString:   "%int",
+					Value:         "%int",
+					ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 26, End: 28}},
+				},
+			}, completions)
+		})
+
 		t.Run("suggest pattern namespace from first letter", func(t *testing.T) {
 			state := newState()
 			chunk, _ := parseChunkSource("pnamespace namespace. = 1; %n", "")
@@ -1034,6 +1147,202 @@ func runSingleModeTests(t *testing.T, mode Mode, wd, dir string) {
 		}, completions)
 	})
 
+	t.Run("path: listing times out", func(t *testing.T) {
+		fls := fs_ns.NewMemFilesystem(1_000_000)
+		util.WriteFile(fls, "/dir/file1.txt", nil, 0600)
+		util.WriteFile(fls, "/dir/file2.txt", nil, 0600)
+
+		release := make(chan struct{})
+		defer close(release)
+
+		state := core.NewTreeWalkState(core.NewContext(core.ContextConfig{
+			Permissions: []core.Permission{
+				core.FilesystemPermission{Kind_: permkind.Read, Entity: core.PathPattern("/...")},
+			},
+			Filesystem: blockingStatFilesystem{
+				Filesystem:  fls,
+				blockedFile: "file2.txt",
+				release:     release,
+			},
+			OperationTimeout: 100 * time.Millisecond,
+		}))
+		defer state.Global.Ctx.CancelGracefully()
+
+		code := "/dir/f"
+		chunk, _ := parseChunkSource(code, "")
+
+		doSymbolicCheck(chunk, state.Global)
+		completions := findCompletions(state, chunk, len(code))
+
+		//only the file listed before the timeout should be suggested.
+		assert.EqualValues(t, []Completion{
+			{
+				ShownString: "file1.txt",
+				Value:       "/dir/file1.txt",
+				ReplacedRange: parse.SourcePositionRange{
+					Span: parse.NodeSpan{Start: 0, End: int32(len(code))},
+				},
+			},
+		}, completions)
+	})
+
+	t.Run("URL query parameters", func(t *testing.T) {
+		host := core.Host("https://example.com")
+
+		newStateWithHostDefinition := func() *core.TreeWalkState {
+			return core.NewTreeWalkState(core.NewContext(core.ContextConfig{
+				Permissions: perms,
+				HostDefinitions: map[core.Host]core.Value{
+					host: core.NewObjectFromMapNoInit(core.ValMap{
+						QUERY_PARAMS_HOST_DEFINITION_PROPNAME: core.NewWrappedValueList(core.String("page"), core.String("per-page"), core.String("sort")),
+					}),
+				},
+			}))
+		}
+
+		t.Run("empty parameter name", func(t *testing.T) {
+			state := newStateWithHostDefinition()
+			defer state.Global.Ctx.CancelGracefully()
+
+			code := "https://example.com/?"
+			chunk, _ := parseChunkSource(code, "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, len(code))
+			span := parse.NodeSpan{Start: 0, End: int32(len(code))}
+
+			assert.EqualValues(t, []Completion{
+				{ShownString: "page", Value: code + "page=", ReplacedRange: parse.SourcePositionRange{Span: span}},
+				{ShownString: "per-page", Value: code + "per-page=", ReplacedRange: parse.SourcePositionRange{Span: span}},
+				{ShownString: "sort", Value: code + "sort=", ReplacedRange: parse.SourcePositionRange{Span: span}},
+			}, completions)
+		})
+
+		t.Run("start of second parameter name", func(t *testing.T) {
+			state := newStateWithHostDefinition()
+			defer state.Global.Ctx.CancelGracefully()
+
+			code := "https://example.com/?page=1&p"
+			chunk, _ := parseChunkSource(code, "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, len(code))
+			span := parse.NodeSpan{Start: 0, End: int32(len(code))}
+
+			assert.EqualValues(t, []Completion{
+				{ShownString: "per-page", Value: "https://example.com/?page=1&per-page=", ReplacedRange: parse.SourcePositionRange{Span: span}},
+			}, completions)
+		})
+
+		t.Run("no metadata", func(t *testing.T) {
+			state := newState()
+			defer state.Global.Ctx.CancelGracefully()
+
+			code := "https://example.com/?"
+			chunk, _ := parseChunkSource(code, "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, len(code))
+			assert.Empty(t, completions)
+		})
+	})
+
+	t.Run("boolean and nil literals", func(t *testing.T) {
+
+		t.Run("in if statement's test", func(t *testing.T) {
+			state := newState()
+			chunk, _ := parseChunkSource("if tru {}", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 6)
+			assert.EqualValues(t, []Completion{
+				{ShownString: "true", Value: "true", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 3, End: 6}}},
+			}, completions)
+		})
+
+		t.Run("in if expression's test", func(t *testing.T) {
+			state := newState()
+			chunk, _ := parseChunkSource("(if f 1)", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 5)
+			assert.EqualValues(t, []Completion{
+				{ShownString: "false", Value: "false", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 4, End: 5}}},
+			}, completions)
+		})
+
+		if mode == LspCompletions {
+			t.Run("nilable boolean parameter", func(t *testing.T) {
+				state := newState()
+				state.Global.Ctx.AddNamedPattern("bool", core.BOOL_PATTERN)
+				state.Global.Ctx.AddNamedPattern("nil", core.NIL_PATTERN)
+				chunk, _ := parseChunkSource("fn g(b %| bool | nil){}; g(n)", "")
+
+				doSymbolicCheck(chunk, state.Global)
+				completions := findCompletions(state, chunk, 28)
+				assert.EqualValues(t, []Completion{
+					{ShownString: "nil", Value: "nil", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 27, End: 28}}},
+				}, completions)
+			})
+
+			t.Run("integer parameter", func(t *testing.T) {
+				state := newState()
+				state.Global.Ctx.AddNamedPattern("int", core.INT_PATTERN)
+				chunk, _ := parseChunkSource("fn g(i int){}; g(tru)", "")
+
+				doSymbolicCheck(chunk, state.Global)
+				completions := findCompletions(state, chunk, 20)
+				assert.Empty(t, completions)
+			})
+		}
+	})
+
+	t.Run("record literal keys", func(t *testing.T) {
+		if mode == ShellCompletions {
+			t.Skip()
+		}
+
+		t.Run("empty record in call", func(t *testing.T) {
+			state := newState()
+			state.Global.Ctx.AddNamedPattern("int", core.INT_PATTERN)
+			state.Global.Ctx.AddNamedPattern("str", core.STR_PATTERN)
+			chunk, _ := parseChunkSource("fn g(r #{name: str, age: int}){}; g(#{})", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 38)
+			assert.ElementsMatch(t, []Completion{
+				{ShownString: "age", Value: "age", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 38, End: 38}}},
+				{ShownString: "name", Value: "name", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 38, End: 38}}},
+			}, completions)
+		})
+
+		t.Run("start of key in call", func(t *testing.T) {
+			state := newState()
+			state.Global.Ctx.AddNamedPattern("int", core.INT_PATTERN)
+			state.Global.Ctx.AddNamedPattern("str", core.STR_PATTERN)
+			chunk, _ := parseChunkSource("fn g(r #{name: str, age: int}){}; g(#{n})", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 39)
+			assert.EqualValues(t, []Completion{
+				{ShownString: "name", Value: "name", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 38, End: 39}}},
+			}, completions)
+		})
+
+		t.Run("key already present", func(t *testing.T) {
+			state := newState()
+			state.Global.Ctx.AddNamedPattern("int", core.INT_PATTERN)
+			state.Global.Ctx.AddNamedPattern("str", core.STR_PATTERN)
+			chunk, _ := parseChunkSource("fn g(r #{name: str, age: int}){}; g(#{name: \"a\", a})", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 50)
+			assert.EqualValues(t, []Completion{
+				{ShownString: "age", Value: "age", ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 49, End: 50}}},
+			}, completions)
+		})
+	})
+
 	t.Run("break", func(t *testing.T) {
 
 		t.Run("in for statement's block", func(t *testing.T) {
@@ -1260,6 +1569,53 @@ func runSingleModeTests(t *testing.T, mode Mode, wd, dir string) {
 		})
 	})
 
+	t.Run("xml closing tag", func(t *testing.T) {
+		t.Run("partially typed closing tag", func(t *testing.T) {
+			state := core.NewTreeWalkState(core.NewContext(core.ContextConfig{Permissions: perms}))
+			defer state.Global.Ctx.CancelGracefully()
+
+			chunk, _ := parseChunkSource("html<div>a</d", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 13)
+			assert.EqualValues(t, []Completion{
+				{
+					ShownString:   "</div>",
+					Value:         "</div>",
+					ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 10, End: 13}},
+				},
+			}, completions)
+		})
+
+		t.Run("closing tag without name", func(t *testing.T) {
+			state := core.NewTreeWalkState(core.NewContext(core.ContextConfig{Permissions: perms}))
+			defer state.Global.Ctx.CancelGracefully()
+
+			chunk, _ := parseChunkSource("html<div>a</", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 12)
+			assert.EqualValues(t, []Completion{
+				{
+					ShownString:   "</div>",
+					Value:         "</div>",
+					ReplacedRange: parse.SourcePositionRange{Span: parse.NodeSpan{Start: 10, End: 12}},
+				},
+			}, completions)
+		})
+
+		t.Run("partially typed closing tag not matching the opening tag", func(t *testing.T) {
+			state := core.NewTreeWalkState(core.NewContext(core.ContextConfig{Permissions: perms}))
+			defer state.Global.Ctx.CancelGracefully()
+
+			chunk, _ := parseChunkSource("html<div>a</s", "")
+
+			doSymbolicCheck(chunk, state.Global)
+			completions := findCompletions(state, chunk, 13)
+			assert.Empty(t, completions)
+		})
+	})
+
 	t.Run("html attribute values", func(t *testing.T) {
 		if mode != LspCompletions {
 			return
@@ -1431,3 +1787,17 @@ func makeFilesystem() *fs_ns.MemFilesystem {
 
 	return fls
 }
+
+// blockingStatFilesystem is a filesystem whose Stat method blocks for a given file until .release is closed.
+type blockingStatFilesystem struct {
+	afs.Filesystem
+	blockedFile string
+	release     chan struct{}
+}
+
+func (fls blockingStatFilesystem) Stat(filename string) (os.FileInfo, error) {
+	if filepath.Base(filename) == fls.blockedFile {
+		<-fls.release
+	}
+	return fls.Filesystem.Stat(filename)
+}
@@ -0,0 +1,37 @@
+package codecompletion
+
+import (
+	"github.com/inoxlang/inox/internal/core/symbolic"
+	"github.com/inoxlang/inox/internal/help"
+	"github.com/inoxlang/inox/internal/parse"
+)
+
+// findHoverInfo returns a single completion containing the symbolic type of $n and the documentation
+// of its value, nil is returned if the type of $n is not known.
+func findHoverInfo(n parse.Node, search completionSearch) []Completion {
+	data := search.state.Global.SymbolicData
+	if data == nil {
+		return nil
+	}
+
+	value, ok := data.GetMostSpecificNodeValue(n)
+	if !ok {
+		return nil
+	}
+
+	stringified := symbolic.Stringify(value)
+
+	var markdownDocumentation string
+	if goFunc, ok := value.(*symbolic.GoFunction); ok {
+		markdownDocumentation, _ = help.HelpForSymbolicGoFunc(goFunc, helpMessageConfig)
+	}
+
+	return []Completion{
+		{
+			ShownString:           stringified,
+			Value:                 stringified,
+			LabelDetail:           stringified,
+			MarkdownDocumentation: markdownDocumentation,
+		},
+	}
+}
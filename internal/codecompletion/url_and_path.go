@@ -1,6 +1,7 @@
 package codecompletion
 
 import (
+	"errors"
 	"path"
 	"path/filepath"
 	"slices"
@@ -23,7 +24,6 @@ import (
 func findPathCompletions(ctx *core.Context, pth string) []Completion {
 	var completions []Completion
 
-	fls := ctx.GetFileSystem()
 	dir := path.Dir(pth)
 	base := path.Base(pth)
 
@@ -31,8 +31,9 @@ func findPathCompletions(ctx *core.Context, pth string) []Completion {
 		base = ""
 	}
 
+	//if the listing times out the files listed so far are used.
 	entries, err := fs_ns.ListFiles(ctx, core.ToValueOptionalParam(core.Path(dir+"/")))
-	if err != nil {
+	if err != nil && !errors.Is(err, core.ErrOperationTimeout) {
 		return nil
 	}
 
@@ -45,8 +46,7 @@ func findPathCompletions(ctx *core.Context, pth string) []Completion {
 				pth = "./" + pth
 			}
 
-			stat, _ := fls.Stat(pth)
-			if stat.IsDir() {
+			if e.IsDir() {
 				pth += "/"
 			}
 
@@ -62,6 +62,10 @@ func findPathCompletions(ctx *core.Context, pth string) []Completion {
 	return completions
 }
 
+// QUERY_PARAMS_HOST_DEFINITION_PROPNAME is the name of the property that lists the known query parameters
+// of a host in its resolution data (object).
+const QUERY_PARAMS_HOST_DEFINITION_PROPNAME = "query-params"
+
 func findURLCompletions(ctx *core.Context, node *parse.URLLiteral, search completionSearch) (completions []Completion) {
 
 	res, err := core.EvalSimpleValueLiteral(node, nil)
@@ -71,6 +75,10 @@ func findURLCompletions(ctx *core.Context, node *parse.URLLiteral, search comple
 	u := res.(core.URL)
 	urlString := string(u)
 
+	if strings.Contains(urlString, "?") {
+		return findURLQueryParamCompletions(ctx, u)
+	}
+
 	if call, ok := search.parent.(*parse.CallExpression); ok {
 
 		var S3_FNS = []string{"get", "delete", "ls"}
@@ -147,6 +155,64 @@ func findURLCompletions(ctx *core.Context, node *parse.URLLiteral, search comple
 	return completions
 }
 
+// findURLQueryParamCompletions suggests the names of the query parameters declared in the resolution data
+// of the URL's host, nil is returned if there is no such data.
+func findURLQueryParamCompletions(ctx *core.Context, u core.URL) (completions []Completion) {
+	definition, ok := ctx.LookupHostDefinition(u.Host())
+	if !ok {
+		return nil
+	}
+
+	data, ok := definition.(*core.Object)
+	if !ok || !data.HasProp(ctx, QUERY_PARAMS_HOST_DEFINITION_PROPNAME) {
+		return nil
+	}
+
+	paramNames, ok := data.Prop(ctx, QUERY_PARAMS_HOST_DEFINITION_PROPNAME).(*core.List)
+	if !ok {
+		return nil
+	}
+
+	urlString := string(u)
+	queryStart := strings.LastIndex(urlString, "?") + 1
+
+	//determine the parameter being written and the parameters already present.
+	lastParamStart := queryStart + strings.LastIndex(urlString[queryStart:], "&") + 1
+	lastParam := urlString[lastParamStart:]
+
+	if strings.Contains(lastParam, "=") {
+		return nil
+	}
+
+	var presentParams []string
+	if lastParamStart > queryStart {
+		for _, param := range strings.Split(urlString[queryStart:lastParamStart-1], "&") {
+			name, _, _ := strings.Cut(param, "=")
+			presentParams = append(presentParams, name)
+		}
+	}
+
+	for i := 0; i < paramNames.Len(); i++ {
+		name, ok := paramNames.At(ctx, i).(core.StringLike)
+		if !ok {
+			continue
+		}
+		paramName := name.GetOrBuildString()
+
+		if !strings.HasPrefix(paramName, lastParam) || slices.Contains(presentParams, paramName) {
+			continue
+		}
+
+		completions = append(completions, Completion{
+			ShownString: paramName,
+			Value:       urlString[:lastParamStart] + paramName + "=",
+			Kind:        defines.CompletionItemKindProperty,
+		})
+	}
+
+	return completions
+}
+
 func findURLPatternCompletions(ctx *core.Context, node *parse.URLPatternLiteral, search completionSearch) (completions []Completion) {
 	globalState := search.state.Global
 
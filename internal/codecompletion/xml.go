@@ -1,10 +1,12 @@
 package codecompletion
 
 import (
+	"strings"
 	"unicode"
 
 	"github.com/inoxlang/inox/internal/globals/globalnames"
 	parse "github.com/inoxlang/inox/internal/parse"
+	"github.com/inoxlang/inox/internal/projectserver/lsp/defines"
 )
 
 // findXmlTagAndTagNameCompletions finds tag name and whole tag completions based on the namespace (e.g. html) of the closest Inox XML expression.
@@ -72,6 +74,39 @@ func findXMLOpeningElementInteriorCompletions(openingElem *parse.XMLOpeningEleme
 	return
 }
 
+// findXMLClosingTagCompletions suggests a closing tag (e.g. `</div>`) matching the opening tag of the element
+// if the closing tag of the element is missing or incomplete.
+func findXMLClosingTagCompletions(closingElem *parse.XMLClosingElement, search completionSearch) (completions []Completion) {
+	xmlElem, _, found := parse.FindClosest(search.ancestorChain, (*parse.XMLElement)(nil))
+	if !found || xmlElem.Closing != closingElem || xmlElem.Opening == nil {
+		return nil
+	}
+
+	if _, ok := xmlElem.Opening.Name.(*parse.IdentifierLiteral); !ok {
+		return nil
+	}
+
+	tagName := xmlElem.Opening.GetName()
+
+	//do not suggest anything if the element is properly closed.
+	if closingName, ok := closingElem.Name.(*parse.IdentifierLiteral); ok {
+		if closingElem.Err == nil || !strings.HasPrefix(tagName, closingName.Name) {
+			return nil
+		}
+	}
+
+	closingTag := "</" + tagName + ">"
+
+	return []Completion{
+		{
+			ShownString:   closingTag,
+			Value:         closingTag,
+			Kind:          defines.CompletionItemKindProperty,
+			ReplacedRange: search.chunk.GetSourcePosition(closingElem.Span),
+		},
+	}
+}
+
 // findXmlAttributeNameCompletions finds completions for atribute names inside an Inox XML opening element,
 // this is based on the namespace (e.g. html) of the closest Inox XML expression.
 func findXmlAttributeNameCompletions(ident *parse.IdentifierLiteral, parent *parse.XMLAttribute, ancestors []parse.Node) (completions []Completion) {
@@ -1,8 +1,11 @@
 package core
 
 import (
+	"cmp"
 	"errors"
 	"fmt"
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/inoxlang/inox/internal/parse"
@@ -21,6 +24,23 @@ func (b *Bytecode) Constants() []Value {
 	return b.constants
 }
 
+// ConstantsMatching returns the constants for which pred returns true, the constants of nested bytecodes
+// (e.g. embedded modules of lthreads) are included.
+func (b *Bytecode) ConstantsMatching(pred func(Value) bool) []Value {
+	var constants []Value
+
+	for _, constant := range b.constants {
+		if nested, ok := constant.(*Bytecode); ok {
+			constants = append(constants, nested.ConstantsMatching(pred)...)
+			continue
+		}
+		if pred(constant) {
+			constants = append(constants, constant)
+		}
+	}
+	return constants
+}
+
 func (b *Bytecode) FormatInstructions(ctx *Context, leftPadding string) []string {
 	return FormatInstructions(ctx, b.main.Instructions, 0, leftPadding, b.constants)
 }
@@ -52,12 +72,93 @@ func (b *Bytecode) Format(ctx *Context, leftPadding string) string {
 	return s
 }
 
+// DiffBytecode returns a unified-diff-style comparison of the disassemblies of the main functions of a and b.
+// Lines are aligned on the opcode sequence: instruction addresses and constant indexes are ignored when comparing
+// instructions, constants are compared by representation. An empty string is returned if there is no difference.
+func DiffBytecode(a, b *Bytecode, ctx *Context) string {
+	linesA, keysA := disassembleForDiff(ctx, a)
+	linesB, keysB := disassembleForDiff(ctx, b)
+
+	//lengths of the longest common subsequences of keysA[i:] and keysB[j:].
+	lcs := make([][]int, len(keysA)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(keysB)+1)
+	}
+
+	for i := len(keysA) - 1; i >= 0; i-- {
+		for j := len(keysB) - 1; j >= 0; j-- {
+			if keysA[i] == keysB[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var diffLines []string
+	changed := false
+	i, j := 0, 0
+
+	for i < len(keysA) || j < len(keysB) {
+		switch {
+		case i < len(keysA) && j < len(keysB) && keysA[i] == keysB[j]:
+			diffLines = append(diffLines, " "+linesB[j])
+			i++
+			j++
+		case j < len(keysB) && (i == len(keysA) || lcs[i][j+1] >= lcs[i+1][j]):
+			diffLines = append(diffLines, "+"+linesB[j])
+			changed = true
+			j++
+		default:
+			diffLines = append(diffLines, "-"+linesA[i])
+			changed = true
+			i++
+		}
+	}
+
+	if !changed {
+		return ""
+	}
+
+	return "--- a\n+++ b\n" + strings.Join(diffLines, "\n") + "\n"
+}
+
+// disassembleForDiff returns the disassembled instructions of the main function of a bytecode, and for each
+// instruction a key that does not depend on the instruction's address and on the indexes of the constants.
+func disassembleForDiff(ctx *Context, b *Bytecode) (lines []string, keys []string) {
+	lines = b.FormatInstructions(ctx, "")
+
+	_, err := MapInstructions(b.main.Instructions, b.constants, func(instr []byte, op Opcode, operands, constantIndexOperandIndexes []int, constants []Value, i int) ([]byte, error) {
+		key := OpcodeNames[op]
+
+		for operandIndex, operand := range operands {
+			if slices.Contains(constantIndexOperandIndexes, operandIndex) {
+				continue
+			}
+			key += " " + strconv.Itoa(operand)
+		}
+
+		for _, constant := range constants {
+			key += " : " + Stringify(constant, nil)
+		}
+
+		keys = append(keys, key)
+		return nil, nil
+	})
+
+	if err != nil {
+		panic(err)
+	}
+	return
+}
+
 // A CompiledFunction contains the bytecode instructions of a module or a compiled Inox function.
 // The compilation of a module produces a *CompiledFunction that is the "main" function.
 type CompiledFunction struct {
 	ParamCount   int
 	IsVariadic   bool
-	LocalCount   int // includes parameters
+	LocalCount   int      // includes parameters
+	LocalNames   []string //optional, name of the local for each slot, used for debugging
 	Instructions []byte
 	SourceMap    map[int]instructionSourcePosition
 	Bytecode     *Bytecode //bytecode containing the function
@@ -66,6 +167,14 @@ type CompiledFunction struct {
 	IncludedChunk  *parse.ParsedChunkSource //set if the function is defined in an included chunk
 }
 
+// LocalName returns the name of the local at the given slot, local#<slot> is returned if the name is not known.
+func (fn *CompiledFunction) LocalName(slot int) string {
+	if slot >= 0 && slot < len(fn.LocalNames) {
+		return fn.LocalNames[slot]
+	}
+	return "local#" + strconv.Itoa(slot)
+}
+
 // GetSourcePositionRange returns the position in source code of the instruction at the ip address,
 // several subsequent instructions can have the same position.
 func (fn *CompiledFunction) GetSourcePositionRange(ip int) parse.SourcePositionRange {
@@ -83,6 +192,66 @@ func (fn *CompiledFunction) GetSourcePositionRange(ip int) parse.SourcePositionR
 	return info.chunk.GetSourcePosition(info.span)
 }
 
+// ExecutedSpans returns the distinct source spans of the executed instructions, the spans are sorted by start position.
+// Instructions located in included chunks are ignored.
+func (fn *CompiledFunction) ExecutedSpans(executedIPs map[int]bool) []parse.NodeSpan {
+	var spans []parse.NodeSpan
+	addedSpans := map[parse.NodeSpan]struct{}{}
+
+	for ip, executed := range executedIPs {
+		if !executed {
+			continue
+		}
+		info, ok := fn.SourceMap[ip]
+		if !ok || info.chunk == nil || info.chunk != fn.mainChunk() {
+			continue
+		}
+		if _, ok := addedSpans[info.span]; !ok {
+			addedSpans[info.span] = struct{}{}
+			spans = append(spans, info.span)
+		}
+	}
+
+	slices.SortFunc(spans, func(a, b parse.NodeSpan) int {
+		if a.Start != b.Start {
+			return cmp.Compare(a.Start, b.Start)
+		}
+		return cmp.Compare(a.End, b.End)
+	})
+
+	return spans
+}
+
+func (fn *CompiledFunction) mainChunk() *parse.ParsedChunkSource {
+	if fn.IncludedChunk != nil {
+		return fn.IncludedChunk
+	}
+	if fn.Bytecode == nil || fn.Bytecode.module == nil {
+		return nil
+	}
+	return fn.Bytecode.module.MainChunk
+}
+
+// LineCoverage maps the source lines of the main function's instructions to their hit status:
+// a line is hit if at least one of the instructions starting on it has been executed.
+func (b *Bytecode) LineCoverage(executedIPs map[int]bool) map[int]bool {
+	coverage := map[int]bool{}
+	chunk := b.main.mainChunk()
+	if chunk == nil {
+		return coverage
+	}
+
+	for ip, info := range b.main.SourceMap {
+		if info.chunk != chunk {
+			continue
+		}
+		line := int(chunk.GetSourcePosition(info.span).StartLine)
+		coverage[line] = coverage[line] || executedIPs[ip]
+	}
+
+	return coverage
+}
+
 type instructionSourcePosition struct {
 	chunk *parse.ParsedChunkSource
 	span  parse.NodeSpan
@@ -129,6 +298,46 @@ func MapInstructions(b []byte, constants []Value, callbackFn InstructionCallback
 	return newInstructions, nil
 }
 
+// VerifyConstantIndices checks that the constant indexes of the instructions of fn are valid indexes in constants,
+// an error wrapping ErrConstantIndexOutOfRange is returned for the first invalid index.
+func VerifyConstantIndices(fn *CompiledFunction, constants []Value) error {
+	_, err := MapInstructions(fn.Instructions, nil, func(instr []byte, op Opcode, operands, _ []int, _ []Value, i int) ([]byte, error) {
+		for operandIndex, operand := range operands {
+			if OpcodeConstantIndexes[op][operandIndex] && operand >= len(constants) {
+				return nil, fmt.Errorf("%w: %s at address %d refers to the constant %d but there are %d constants",
+					ErrConstantIndexOutOfRange, OpcodeNames[op], i, operand, len(constants))
+			}
+		}
+		return nil, nil
+	})
+	return err
+}
+
+// VerifyConstantIndices checks the constant indexes of the main function and of the compiled functions
+// of the bytecode, nested bytecodes (e.g. embedded modules of lthreads) are also checked.
+func (b *Bytecode) VerifyConstantIndices() error {
+	if err := VerifyConstantIndices(b.main, b.constants); err != nil {
+		return err
+	}
+
+	for _, constant := range b.constants {
+		switch c := constant.(type) {
+		case *InoxFunction:
+			if c.compiledFunction == nil {
+				continue
+			}
+			if err := VerifyConstantIndices(c.compiledFunction, b.constants); err != nil {
+				return err
+			}
+		case *Bytecode:
+			if err := c.VerifyConstantIndices(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
 // Opcode represents a single byte operation code.
 type Opcode = byte
 
@@ -235,6 +444,7 @@ const (
 	OpAddPatternNamespace
 	OpPatternNamespaceMemb
 	OpSetMember
+	OpSetComputedMember
 	OpSetIndex
 	OpSetSlice
 	OpSetBoolField
@@ -261,6 +471,7 @@ const (
 	OpConcatStrLikes
 	OpConcatBytesLikes
 	OpConcatTuples
+	OpConcatLists
 	OpRange
 	OpMemb
 	OpGetBoolField
@@ -282,8 +493,11 @@ const (
 	OpRuntimeTypecheck
 	OpPushIncludedChunk
 	OpPopIncludedChunk
+	OpIncLocal
 	OpNoOp
 	OpSuspendVM
+	OpCreateSteppedRange
+	OpAs
 )
 
 // OpcodeNames contains the string representation of each opcode.
@@ -372,6 +586,7 @@ var OpcodeNames = [...]string{
 	OpGetGlobal:                    "GET_GLOBAL",
 	OpSetGlobal:                    "SET_GLOBAL",
 	OpSetMember:                    "SET_MEMBER",
+	OpSetComputedMember:            "SET_COMPUTED_MEMBER",
 	OpSetIndex:                     "SET_INDEX",
 	OpSetSlice:                     "SET_SLICE",
 	OpSetBoolField:                 "SET_BOOL_FIELD",
@@ -416,6 +631,7 @@ var OpcodeNames = [...]string{
 	OpConcatStrLikes:               "CONCAT_STR_LIKES",
 	OpConcatBytesLikes:             "CONCAT_BYTES",
 	OpConcatTuples:                 "CONCAT_TUPLES",
+	OpConcatLists:                  "CONCAT_LISTS",
 	OpRange:                        "RANGE",
 	OpMemb:                         "MEMB",
 	OpGetBoolField:                 "GET_BOOL_FIELD",
@@ -437,8 +653,11 @@ var OpcodeNames = [...]string{
 	OpRuntimeTypecheck:             "TYPECHECK",
 	OpPushIncludedChunk:            "PUSH_CHUNK",
 	OpPopIncludedChunk:             "POP_CHUNK",
+	OpIncLocal:                     "INC_LOCAL",
 	OpNoOp:                         "NO_OP",
 	OpSuspendVM:                    "SUSPEND",
+	OpCreateSteppedRange:           "CRT_STEPPEDRG",
+	OpAs:                           "AS",
 }
 
 // OpcodeOperands contains the number of operands of each opcode.
@@ -543,6 +762,7 @@ var OpcodeOperands = [...][]int{
 	OpAddPatternNamespace:          {2},
 	OpPatternNamespaceMemb:         {2, 2},
 	OpSetMember:                    {2},
+	OpSetComputedMember:            {},
 	OpSetIndex:                     {},
 	OpSetSlice:                     {},
 	OpSetBoolField:                 {2, 2},
@@ -569,6 +789,7 @@ var OpcodeOperands = [...][]int{
 	OpConcatStrLikes:               {2, 2},
 	OpConcatBytesLikes:             {2, 2},
 	OpConcatTuples:                 {2, 2},
+	OpConcatLists:                  {2, 2},
 	OpRange:                        {1},
 	OpMemb:                         {2},
 	OpGetBoolField:                 {2, 2},
@@ -590,8 +811,11 @@ var OpcodeOperands = [...][]int{
 	OpRuntimeTypecheck:             {2},
 	OpPushIncludedChunk:            {2},
 	OpPopIncludedChunk:             {},
+	OpIncLocal:                     {1, 1},
 	OpNoOp:                         {},
 	OpSuspendVM:                    {},
+	OpCreateSteppedRange:           {},
+	OpAs:                           {},
 }
 
 // OpcodeConstantIndexes stores for each opcode what arguments are indexes (positions) of constants.
@@ -697,6 +921,7 @@ var OpcodeConstantIndexes = [...][]bool{
 	OpAddPatternNamespace:          {true},
 	OpPatternNamespaceMemb:         {true, true},
 	OpSetMember:                    {true},
+	OpSetComputedMember:            {},
 	OpSetIndex:                     {},
 	OpSetSlice:                     {},
 	OpSetBoolField:                 {false, false},
@@ -723,6 +948,7 @@ var OpcodeConstantIndexes = [...][]bool{
 	OpConcatStrLikes:               {false, true},
 	OpConcatBytesLikes:             {false, true},
 	OpConcatTuples:                 {false, true},
+	OpConcatLists:                  {false, true},
 	OpRange:                        {false},
 	OpMemb:                         {true},
 	OpGetBoolField:                 {false, false},
@@ -744,8 +970,78 @@ var OpcodeConstantIndexes = [...][]bool{
 	OpRuntimeTypecheck:             {true},
 	OpPushIncludedChunk:            {true},
 	OpPopIncludedChunk:             {},
+	OpIncLocal:                     {false, false},
 	OpNoOp:                         {},
 	OpSuspendVM:                    {},
+	OpCreateSteppedRange:           {},
+	OpAs:                           {},
+}
+
+// OpcodeInfo describes an opcode: its name, the width of each operand and what operands are constant indexes.
+type OpcodeInfo struct {
+	Opcode          Opcode
+	Name            string
+	OperandWidths   []int
+	ConstantIndexes []bool
+}
+
+// AllOpcodes returns information about all named opcodes, in opcode order.
+// The returned slices should not be modified.
+func AllOpcodes() []OpcodeInfo {
+	var opcodes []OpcodeInfo
+
+	for i, name := range OpcodeNames {
+		if name == "" {
+			continue
+		}
+		opcode := Opcode(i)
+
+		opcodes = append(opcodes, OpcodeInfo{
+			Opcode:          opcode,
+			Name:            name,
+			OperandWidths:   OpcodeOperands[opcode],
+			ConstantIndexes: OpcodeConstantIndexes[opcode],
+		})
+	}
+
+	return opcodes
+}
+
+// FormatOpcodeCounters returns a table listing the name and count of all opcodes with a non-zero count,
+// the rows are sorted by decreasing count. counters is indexed by opcode (see VMConfig.OpcodeCounters).
+func FormatOpcodeCounters(counters []uint64) string {
+	type row struct {
+		name  string
+		count uint64
+	}
+
+	var rows []row
+	maxNameLen := len("OPCODE")
+
+	for i, count := range counters {
+		if count == 0 || i >= len(OpcodeNames) || OpcodeNames[i] == "" {
+			continue
+		}
+		name := OpcodeNames[i]
+		rows = append(rows, row{name: name, count: count})
+		maxNameLen = max(maxNameLen, len(name))
+	}
+
+	slices.SortStableFunc(rows, func(a, b row) int {
+		if a.count != b.count {
+			return cmp.Compare(b.count, a.count)
+		}
+		return strings.Compare(a.name, b.name)
+	})
+
+	buf := &strings.Builder{}
+	fmt.Fprintf(buf, "%-*s COUNT\n", maxNameLen, "OPCODE")
+
+	for _, row := range rows {
+		fmt.Fprintf(buf, "%-*s %d\n", maxNameLen, row.name, row.count)
+	}
+
+	return buf.String()
 }
 
 // ReadOperands reads the operands of an instruction in bytecode.
@@ -844,3 +1140,286 @@ func FormatInstructions(ctx *Context, b []byte, posOffset int, leftPadding strin
 
 	return out
 }
+
+var opcodesByName = func() map[string]Opcode {
+	opcodes := map[string]Opcode{}
+	for i, name := range OpcodeNames {
+		if name != "" {
+			opcodes[name] = Opcode(i)
+		}
+	}
+	return opcodes
+}()
+
+// AssembleInstructions assembles instructions from a textual listing. Each line contains an opcode name followed
+// by its operands (e.g. PUSH_CONST 0), lines starting with '#' are ignored. A line consisting of a name followed by
+// a colon defines a label at the position of the next instruction, labels can be used as operands (e.g. JUMP end).
+// Listings returned by FormatInstructions are accepted: positions and constants at the end of lines are ignored.
+func AssembleInstructions(text string) ([]byte, error) {
+	type labelReference struct {
+		label  string
+		offset int //offset of the operand
+		width  int
+		line   int
+	}
+
+	var instructions []byte
+	labels := map[string]int{}
+	var labelReferences []labelReference
+
+	for lineIndex, line := range strings.Split(text, "\n") {
+		lineNumber := lineIndex + 1
+
+		if beforeConstants, _, found := strings.Cut(line, " : "); found {
+			line = beforeConstants
+		}
+		line = strings.TrimSpace(line)
+
+		if line == "" || line[0] == '#' {
+			continue
+		}
+
+		if label, ok := strings.CutSuffix(line, ":"); ok && !strings.ContainsAny(label, " \t") {
+			if _, ok := labels[label]; ok {
+				return nil, fmt.Errorf("line %d: label %q is already defined", lineNumber, label)
+			}
+			labels[label] = len(instructions)
+			continue
+		}
+
+		fields := strings.Fields(line)
+
+		//ignore the position
+		if _, err := strconv.ParseUint(fields[0], 10, 64); err == nil {
+			fields = fields[1:]
+			if len(fields) == 0 {
+				return nil, fmt.Errorf("line %d: missing opcode name", lineNumber)
+			}
+		}
+
+		opcode, ok := opcodesByName[fields[0]]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unknown opcode %q", lineNumber, fields[0])
+		}
+
+		operandWidths := OpcodeOperands[opcode]
+		operandFields := fields[1:]
+
+		if len(operandFields) != len(operandWidths) {
+			return nil, fmt.Errorf("line %d: %s expects %d operand(s) but %d were given",
+				lineNumber, fields[0], len(operandWidths), len(operandFields))
+		}
+
+		operands := make([]int, len(operandFields))
+		operandOffset := len(instructions) + 1
+
+		for i, field := range operandFields {
+			width := operandWidths[i]
+
+			operand, err := strconv.Atoi(field)
+			if err != nil {
+				labelReferences = append(labelReferences, labelReference{
+					label:  field,
+					offset: operandOffset,
+					width:  width,
+					line:   lineNumber,
+				})
+			} else if operand < 0 || operand >= 1<<(8*width) {
+				return nil, fmt.Errorf("line %d: operand %d does not fit in %d byte(s)", lineNumber, operand, width)
+			} else {
+				operands[i] = operand
+			}
+
+			operandOffset += width
+		}
+
+		instructions = append(instructions, MakeInstruction(opcode, operands...)...)
+	}
+
+	for _, ref := range labelReferences {
+		position, ok := labels[ref.label]
+		if !ok {
+			return nil, fmt.Errorf("line %d: undefined label %q", ref.line, ref.label)
+		}
+		if position >= 1<<(8*ref.width) {
+			return nil, fmt.Errorf("line %d: position of label %q does not fit in %d byte(s)", ref.line, ref.label, ref.width)
+		}
+
+		switch ref.width {
+		case 1:
+			instructions[ref.offset] = byte(position)
+		case 2:
+			instructions[ref.offset] = byte(position >> 8)
+			instructions[ref.offset+1] = byte(position)
+		}
+	}
+
+	return instructions, nil
+}
+
+// OpcodeStackEffect returns the net change of the stack pointer caused by the execution of an instruction,
+// constants are only required by instructions whose effect depends on a constant (OpCreateURL).
+// OpAndJump, OpOrJump and OpPopJumpIfTestDisabled have a different effect when they jump, the returned value
+// is the effect when they do not jump (see MaxStackDepth).
+func OpcodeStackEffect(op Opcode, operands []int, constants []Value) (int, error) {
+	switch op {
+	case OpPushConstant, OpPushTrue, OpPushFalse, OpPushNil, OpCopyTop,
+		OpGetLocal, OpGetGlobal, OpGetSelf,
+		OpResolvePattern, OpResolvePatternNamespace, OpPatternNamespaceMemb,
+		OpCreateMapping, OpExtensionMethod, OpAllocStruct:
+		return 1, nil
+	case OpSwap, OpMoveThirdTop, OpMinus, OpBooleanNot, OpToPattern, OpToBool,
+		OpJump, OpPopJumpIfTestDisabled, OpIncLocal, OpNoOp, OpSuspendVM,
+		OpMemb, OpOptionalMemb, OpDynMemb, OpObjPropNotStored,
+		OpGetBoolField, OpGetIntField, OpGetFloatField, OpGetStructPtrField,
+		OpExtractProps, OpCreateOption, OpCreateOptionPattern, OpCreateRepeatedPatternElement,
+		OpCreatePatternNamespace, OpCreateOptionalPattern, OpCreateHost, OpCreateUpperBoundRange,
+		OpCreateTestSuite, OpCreateTestCase, OpLoadDBVal, OptStrQueryParamVal, OpRuntimeTypecheck,
+		OpIterNext, OpIterNextChunk, OpIterKey, OpIterValue, OpIterPrune, OpWalkerInit,
+		OpPushIncludedChunk, OpPopIncludedChunk, OpBlockUnlock:
+		return 0, nil
+	case OpPop, OpJumpIfFalse, OpAndJump, OpOrJump,
+		OpSetLocal, OpSetGlobal, OpSetSelf,
+		OpEqual, OpNotEqual, OpIs, OpIsNot, OpMatch, OpAs, OpGroupMatch, OpIn, OpSubstrOf, OpKeyOf, OpUrlOf,
+		OpDoSetDifference, OpNilCoalesce, OpLess, OpLessEqual, OpGreater, OpGreaterEqual,
+		OpIntBin, OpFloatBin, OpNumBin, OpPseudoArith, OpStrConcat, OpRange,
+		OpComputedMemb, OpAt, OpSafeAt, OpCreateOrderedPair, OpSpreadObject, OpSpreadList, OpSpreadTuple,
+		OpSpreadObjectPattern, OpSpreadRecordPattern, OpCreateReceptionHandler, OpSendValue, OpCallFromXMLFactory,
+		OpCreateRuneRange, OpCreateIntRange, OpCreateFloatRange, OpCreateLifetimeJob, OpSpawnLThread,
+		OpAddPattern, OpAddPatternNamespace, OpAssert, OpDropPerms:
+		return -1, nil
+	case OpSlice, OpSetMember, OpSetBoolField, OpSetIntField, OpSetFloatField, OpSetStructPtrField,
+		OpImport, OpCreateAddTypeExtension, OpCreateSteppedRange:
+		return -2, nil
+	case OpSetComputedMember, OpSetIndex, OpAddTestSuiteResult:
+		return -3, nil
+	case OpSetSlice:
+		return -4, nil
+	case OpCreateList, OpCreateKeyList, OpCreateTuple, OpCreateDict,
+		OpCreateUnionPattern, OpCreateStringUnionPattern, OpCreateObjectPattern, OpCreateRecordPattern,
+		OpConcatStrLikes, OpConcatBytesLikes, OpConcatTuples, OpConcatLists,
+		OpCreateSequenceStringPattern, OpCreatePath, OpCreatePathPattern:
+		return 1 - operands[0], nil
+	case OpCreateObject, OpCreateRecord:
+		return 1 - 2*operands[0], nil
+	case OpCreateStruct, OpCreateString:
+		return 1 - operands[1], nil
+	case OpCreateListPattern, OpCreateTuplePattern:
+		if operands[1] == 1 { //general element
+			return 0, nil
+		}
+		return 1 - operands[0], nil
+	case OpCreateTreedata, OpCreateTreedataHiearchyEntry:
+		return -operands[0], nil
+	case OpCreateXMLelem:
+		attributeCount, childCount := operands[1], operands[3]
+		return 1 - 2*attributeCount - childCount, nil
+	case OpCreateURL:
+		if operands[0] >= len(constants) {
+			return 0, fmt.Errorf("%s: missing constant %d", OpcodeNames[op], operands[0])
+		}
+		info := constants[operands[0]].(*Record)
+		pathSliceCount := int(info.Prop(nil, "path-slice-count").(Int))
+		queryParamCount := info.Prop(nil, "query-params").(*Tuple).Len() / 2
+		//the host is replaced by the URL.
+		return -pathSliceCount - queryParamCount, nil
+	case OpIterInit:
+		if operands[0] == 1 { //key and value filters
+			return -2, nil
+		}
+		return 0, nil
+	case OpCall:
+		numArgs, spread := operands[0], operands[1]
+		//the arguments, the spread argument, self and the callee are popped, the result is stored
+		//in the slot below the arguments.
+		return -numArgs - spread - 2, nil
+	case OpCallPattern, BindCapturedLocals, OpBlockLock:
+		return -operands[0], nil
+	case OpYield:
+		return -operands[0], nil
+	case OpReturn:
+		//the execution of the function ends.
+		return 0, nil
+	case OpCreateListDynLen:
+		return 0, fmt.Errorf("the stack effect of %s is not known statically", OpcodeNames[op])
+	default:
+		if int(op) < len(OpcodeNames) && OpcodeNames[op] != "" {
+			return 0, fmt.Errorf("%s is not supported by the VM", OpcodeNames[op])
+		}
+		return 0, fmt.Errorf("unknown opcode: %d", op)
+	}
+}
+
+// MaxStackDepth returns the maximum depth of the operand stack during the execution of fn, the locals are not
+// counted. The stack effects of the instructions are simulated along all control flow paths, an error is returned
+// if two paths reach the same instruction with different stack depths or if the stack underflows.
+func MaxStackDepth(fn *CompiledFunction) (int, error) {
+	instructions := fn.Instructions
+
+	var constants []Value
+	if fn.Bytecode != nil {
+		constants = fn.Bytecode.constants
+	}
+
+	type pathState struct {
+		ip, depth int
+	}
+
+	depths := map[int]int{} //stack depth before the execution of each visited instruction
+	pending := []pathState{{ip: 0, depth: 0}}
+	maxDepth := 0
+
+	for len(pending) > 0 {
+		state := pending[len(pending)-1]
+		pending = pending[:len(pending)-1]
+
+		ip, depth := state.ip, state.depth
+
+		for ip < len(instructions) {
+			if prevDepth, ok := depths[ip]; ok {
+				if prevDepth != depth {
+					return 0, fmt.Errorf("inconsistent stack depth at position %d: %d and %d", ip, prevDepth, depth)
+				}
+				break
+			}
+			depths[ip] = depth
+
+			op := instructions[ip]
+			if int(op) >= len(OpcodeOperands) {
+				return 0, fmt.Errorf("unknown opcode at position %d: %d", ip, op)
+			}
+			operands, offset := ReadOperands(OpcodeOperands[op], instructions[ip+1:])
+
+			effect, err := OpcodeStackEffect(op, operands, constants)
+			if err != nil {
+				return 0, fmt.Errorf("position %d: %w", ip, err)
+			}
+
+			nextIp := ip + 1 + offset
+
+			switch op {
+			case OpJump:
+				nextIp = operands[0]
+			case OpJumpIfFalse:
+				pending = append(pending, pathState{ip: operands[0], depth: depth + effect})
+			case OpAndJump, OpOrJump:
+				//the value is not popped when jumping.
+				pending = append(pending, pathState{ip: operands[0], depth: depth})
+			case OpPopJumpIfTestDisabled:
+				//the value is popped and the instruction at the target position is skipped.
+				pending = append(pending, pathState{ip: operands[0] + 1, depth: depth - 1})
+			case OpReturn:
+				nextIp = len(instructions)
+			}
+
+			depth += effect
+			if depth < 0 {
+				return 0, fmt.Errorf("stack underflow at position %d", ip)
+			}
+			maxDepth = max(maxDepth, depth)
+			ip = nextIp
+		}
+	}
+
+	return maxDepth, nil
+}
@@ -0,0 +1,498 @@
+package core
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllOpcodes(t *testing.T) {
+	namedOpcodeCount := 0
+	for _, name := range OpcodeNames {
+		if name != "" {
+			namedOpcodeCount++
+		}
+	}
+
+	opcodes := AllOpcodes()
+	if !assert.Len(t, opcodes, namedOpcodeCount) {
+		return
+	}
+
+	assert.Equal(t, OpcodeInfo{
+		Opcode:          OpPushConstant,
+		Name:            "PUSH_CONST",
+		OperandWidths:   []int{2},
+		ConstantIndexes: []bool{true},
+	}, opcodes[0])
+
+	for i := 1; i < len(opcodes); i++ {
+		assert.Less(t, opcodes[i-1].Opcode, opcodes[i].Opcode)
+		assert.Len(t, opcodes[i].ConstantIndexes, len(opcodes[i].OperandWidths), opcodes[i].Name)
+	}
+}
+
+func TestDiffBytecode(t *testing.T) {
+	ctx := NewContext(ContextConfig{})
+	defer ctx.CancelGracefully()
+
+	bytecode, _, err := traceCompile(t, `
+		a = 1
+		return (a + 2)
+	`, nil)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Empty(t, DiffBytecode(bytecode, bytecode, ctx))
+
+	modified, _, err := traceCompile(t, `
+		a = 1
+		return (a + 3)
+	`, nil)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	diff := DiffBytecode(bytecode, modified, ctx)
+	if !assert.NotEmpty(t, diff) {
+		return
+	}
+
+	assert.True(t, strings.HasPrefix(diff, "--- a\n+++ b\n"))
+
+	var removed, added []string
+	for _, line := range strings.Split(strings.TrimSuffix(diff, "\n"), "\n")[2:] {
+		switch line[0] {
+		case '-':
+			removed = append(removed, line)
+		case '+':
+			added = append(added, line)
+		}
+	}
+
+	if assert.Len(t, removed, 1) && assert.Len(t, added, 1) {
+		assert.Contains(t, removed[0], ": 2")
+		assert.Contains(t, added[0], ": 3")
+	}
+}
+
+func TestBytecodeDeduplicateConstants(t *testing.T) {
+	var instructions []byte
+	instructions = append(instructions, MakeInstruction(OpPushConstant, 0)...)
+	instructions = append(instructions, MakeInstruction(OpPushConstant, 1)...)
+	instructions = append(instructions, MakeInstruction(OpPushConstant, 2)...)
+	instructions = append(instructions, MakeInstruction(OpPushConstant, 3)...)
+
+	bytecode := &Bytecode{
+		constants: []Value{Int(1), Int(1), Int(2), Int(1)},
+		main: &CompiledFunction{
+			Instructions: instructions,
+		},
+	}
+	bytecode.main.Bytecode = bytecode
+
+	deduplicated := bytecode.DeduplicateConstants()
+
+	assert.Equal(t, []Value{Int(1), Int(2)}, deduplicated.Constants())
+
+	var expectedInstructions []byte
+	expectedInstructions = append(expectedInstructions, MakeInstruction(OpPushConstant, 0)...)
+	expectedInstructions = append(expectedInstructions, MakeInstruction(OpPushConstant, 0)...)
+	expectedInstructions = append(expectedInstructions, MakeInstruction(OpPushConstant, 1)...)
+	expectedInstructions = append(expectedInstructions, MakeInstruction(OpPushConstant, 0)...)
+
+	assert.Equal(t, expectedInstructions, deduplicated.main.Instructions)
+	assert.Same(t, deduplicated, deduplicated.main.Bytecode)
+
+	//the original bytecode should not be modified.
+	assert.Equal(t, []Value{Int(1), Int(1), Int(2), Int(1)}, bytecode.Constants())
+	assert.Equal(t, instructions, bytecode.main.Instructions)
+}
+
+func TestBytecodeDeduplicateConstantsFunctionAtSeveralIndexes(t *testing.T) {
+	fn := &InoxFunction{
+		compiledFunction: &CompiledFunction{
+			Instructions: MakeInstruction(OpPushConstant, 2),
+		},
+	}
+
+	var instructions []byte
+	instructions = append(instructions, MakeInstruction(OpPushConstant, 3)...)
+	instructions = append(instructions, MakeInstruction(OpPushConstant, 4)...)
+
+	bytecode := &Bytecode{
+		constants: []Value{Int(1), Int(1), Int(2), fn, fn},
+		main: &CompiledFunction{
+			Instructions: instructions,
+		},
+	}
+	bytecode.main.Bytecode = bytecode
+	fn.compiledFunction.Bytecode = bytecode
+
+	deduplicated := bytecode.DeduplicateConstants()
+	constants := deduplicated.Constants()
+
+	if !assert.Len(t, constants, 3) {
+		return
+	}
+	assert.Equal(t, []Value{Int(1), Int(2)}, constants[:2])
+
+	fnCopy := constants[2].(*InoxFunction)
+
+	//the instructions of the function should have been remapped only once: 2 -> 1.
+	assert.Equal(t, MakeInstruction(OpPushConstant, 1), fnCopy.compiledFunction.Instructions)
+
+	var expectedInstructions []byte
+	expectedInstructions = append(expectedInstructions, MakeInstruction(OpPushConstant, 2)...)
+	expectedInstructions = append(expectedInstructions, MakeInstruction(OpPushConstant, 2)...)
+	assert.Equal(t, expectedInstructions, deduplicated.main.Instructions)
+
+	//the original function should not be modified.
+	assert.Equal(t, MakeInstruction(OpPushConstant, 2), fn.compiledFunction.Instructions)
+}
+
+func TestBytecodeDeduplicateConstantsNonPrimitive(t *testing.T) {
+	fn1 := &InoxFunction{}
+	fn2 := &InoxFunction{}
+
+	var instructions []byte
+	for i := 0; i < 4; i++ {
+		instructions = append(instructions, MakeInstruction(OpPushConstant, i)...)
+	}
+
+	bytecode := &Bytecode{
+		constants: []Value{
+			NewTuple([]Serializable{Int(1), String("a")}),
+			NewTuple([]Serializable{Int(1), String("a")}),
+			fn1,
+			fn2,
+		},
+		main: &CompiledFunction{
+			Instructions: instructions,
+		},
+	}
+	bytecode.main.Bytecode = bytecode
+
+	deduplicated := bytecode.DeduplicateConstants()
+
+	//equal immutable values are merged, functions are compared by identity.
+	assert.Equal(t, []Value{NewTuple([]Serializable{Int(1), String("a")}), fn1, fn2}, deduplicated.Constants())
+
+	var expectedInstructions []byte
+	for _, i := range []int{0, 0, 1, 2} {
+		expectedInstructions = append(expectedInstructions, MakeInstruction(OpPushConstant, i)...)
+	}
+	assert.Equal(t, expectedInstructions, deduplicated.main.Instructions)
+}
+
+func TestBytecodeConstantsMatching(t *testing.T) {
+	bytecode, _, err := traceCompile(t, `
+		a = https://example.com/a
+		b = https://example.com/b
+		c = /dir/
+		d = go do {
+			return https://example.com/d
+		}
+	`, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	urls := bytecode.ConstantsMatching(func(v Value) bool {
+		_, ok := v.(URL)
+		return ok
+	})
+
+	assert.ElementsMatch(t, []Value{
+		URL("https://example.com/a"),
+		URL("https://example.com/b"),
+		URL("https://example.com/d"),
+	}, urls)
+}
+
+func TestAssembleInstructions(t *testing.T) {
+
+	t.Run("round trip", func(t *testing.T) {
+		instructions, err := AssembleInstructions(`
+			# if-else
+			PUSH_CONST 0
+			JUMP_IFF else
+			PUSH_TRUE
+			JUMP end
+			else:
+			PUSH_FALSE
+			end:
+			POP
+		`)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, []byte{
+			OpPushConstant, 0, 0,
+			OpJumpIfFalse, 0, 10,
+			OpPushTrue,
+			OpJump, 0, 11,
+			OpPushFalse,
+			OpPop,
+		}, instructions)
+
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		formatted := FormatInstructions(ctx, instructions, 0, "", nil)
+
+		var listing []string
+		for _, line := range formatted {
+			listing = append(listing, strings.Join(strings.Fields(line), " "))
+		}
+
+		assert.Equal(t, []string{
+			"0000 PUSH_CONST 0",
+			"0003 JUMP_IFF 10",
+			"0006 PUSH_TRUE",
+			"0007 JUMP 11",
+			"0010 PUSH_FALSE",
+			"0011 POP",
+		}, listing)
+
+		reassembled, err := AssembleInstructions(strings.Join(formatted, "\n"))
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, instructions, reassembled)
+	})
+
+	t.Run("unknown opcode", func(t *testing.T) {
+		_, err := AssembleInstructions("PUSH_CONST 0\nUNKNOWN")
+		assert.EqualError(t, err, `line 2: unknown opcode "UNKNOWN"`)
+	})
+
+	t.Run("invalid operand count", func(t *testing.T) {
+		_, err := AssembleInstructions("PUSH_CONST")
+		assert.EqualError(t, err, "line 1: PUSH_CONST expects 1 operand(s) but 0 were given")
+	})
+
+	t.Run("operand too large", func(t *testing.T) {
+		_, err := AssembleInstructions("PUSH_CONST 65536")
+		assert.EqualError(t, err, "line 1: operand 65536 does not fit in 2 byte(s)")
+	})
+
+	t.Run("undefined label", func(t *testing.T) {
+		_, err := AssembleInstructions("JUMP end")
+		assert.EqualError(t, err, `line 1: undefined label "end"`)
+	})
+
+	t.Run("label defined twice", func(t *testing.T) {
+		_, err := AssembleInstructions("end:\nend:")
+		assert.EqualError(t, err, `line 2: label "end" is already defined`)
+	})
+}
+
+func TestFuseLocalIncrements(t *testing.T) {
+	testCases := []struct {
+		name   string
+		code   string
+		result Value
+		fused  bool
+	}{
+		{"top level", `i = 0; i += 1; i += 1; return i`, Int(2), true},
+		{"in loop", `i = 0; for j in 1..3 { i += 1 }; return i`, Int(3), true},
+		{"in function", `fn f(){ var i = 0; i += 1; return i }; return f()`, Int(1), true},
+		{"increment by 2", `i = 0; i += 2; return i`, Int(2), false},
+	}
+
+	containsIncLocal := func(b *Bytecode) bool {
+		found := false
+		check := func(instructions []byte) {
+			MapInstructions(instructions, nil, func(instr []byte, op Opcode, _, _ []int, _ []Value, _ int) ([]byte, error) {
+				found = found || op == OpIncLocal
+				return nil, nil
+			})
+		}
+
+		check(b.main.Instructions)
+		for _, c := range b.constants {
+			if fn, ok := c.(*InoxFunction); ok && fn.compiledFunction != nil {
+				check(fn.compiledFunction.Instructions)
+			}
+		}
+		return found
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			unoptimized, _, err := traceCompile(t, testCase.code, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			optimized, _, err := traceCompile(t, testCase.code, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+			optimizeBytecode(optimized, nil)
+
+			assert.Equal(t, testCase.fused, containsIncLocal(optimized))
+
+			unoptimizedState := NewGlobalState(NewDefaultTestContext())
+			defer unoptimizedState.Ctx.CancelGracefully()
+
+			unoptimizedResult, err := EvalBytecode(unoptimized, unoptimizedState, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			optimizedState := NewGlobalState(NewDefaultTestContext())
+			defer optimizedState.Ctx.CancelGracefully()
+
+			optimizedResult, err := EvalBytecode(optimized, optimizedState, nil)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, testCase.result, unoptimizedResult)
+			assert.Equal(t, unoptimizedResult, optimizedResult)
+		})
+	}
+}
+
+func TestMaxStackDepth(t *testing.T) {
+
+	t.Run("straight line", func(t *testing.T) {
+		instructions, err := AssembleInstructions(`
+			PUSH_CONST 0
+			PUSH_CONST 1
+			PUSH_CONST 2
+			POP
+			POP
+			PUSH_TRUE
+			POP
+			POP
+		`)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		depth, err := MaxStackDepth(&CompiledFunction{Instructions: instructions})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 3, depth)
+	})
+
+	t.Run("branching", func(t *testing.T) {
+		instructions, err := AssembleInstructions(`
+			PUSH_TRUE
+			JUMP_IFF else
+			PUSH_CONST 0
+			PUSH_CONST 1
+			PUSH_CONST 2
+			POP
+			POP
+			JUMP end
+			else:
+			PUSH_FALSE
+			end:
+			POP
+		`)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		depth, err := MaxStackDepth(&CompiledFunction{Instructions: instructions})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 3, depth)
+	})
+
+	t.Run("inconsistent merge point", func(t *testing.T) {
+		instructions, err := AssembleInstructions(`
+			PUSH_TRUE
+			JUMP_IFF end
+			PUSH_CONST 0
+			end:
+			PUSH_NIL
+		`)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		_, err = MaxStackDepth(&CompiledFunction{Instructions: instructions})
+		assert.ErrorContains(t, err, "inconsistent stack depth at position 7")
+	})
+
+	t.Run("compiled module", func(t *testing.T) {
+		bytecode, _, err := traceCompile(t, `
+			a = 1
+			return (a + 2)
+		`, nil)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		depth, err := MaxStackDepth(bytecode.main)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 2, depth)
+	})
+}
+
+func TestVerifyConstantIndices(t *testing.T) {
+	constants := []Value{Int(1), Int(2)}
+
+	t.Run("valid indexes", func(t *testing.T) {
+		fn := &CompiledFunction{
+			Instructions: append(append(
+				MakeInstruction(OpPushConstant, 0),
+				MakeInstruction(OpPushConstant, 1)...),
+				MakeInstruction(OpPop)...,
+			),
+		}
+		assert.NoError(t, VerifyConstantIndices(fn, constants))
+	})
+
+	t.Run("out-of-range index", func(t *testing.T) {
+		fn := &CompiledFunction{
+			Instructions: append(
+				MakeInstruction(OpPushConstant, 0),
+				MakeInstruction(OpPushConstant, 2)...,
+			),
+		}
+		err := VerifyConstantIndices(fn, constants)
+		if !assert.ErrorIs(t, err, ErrConstantIndexOutOfRange) {
+			return
+		}
+		assert.Contains(t, err.Error(), "address 3")
+	})
+
+	t.Run("no constants", func(t *testing.T) {
+		fn := &CompiledFunction{
+			Instructions: MakeInstruction(OpPushConstant, 0),
+		}
+		assert.ErrorIs(t, VerifyConstantIndices(fn, nil), ErrConstantIndexOutOfRange)
+	})
+
+	t.Run("compiled module", func(t *testing.T) {
+		bytecode, _, err := traceCompile(t, `
+			fn f(){
+				return 1
+			}
+			return f()
+		`, nil)
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.NoError(t, bytecode.VerifyConstantIndices())
+	})
+}
@@ -94,6 +94,31 @@ func TestCompileModule(t *testing.T) {
 		)
 	})
 
+	t.Run("integer range literals", func(t *testing.T) {
+		expectBytecode(t, `1..2`,
+			0,
+			instrs(
+				inst(OpPushConstant, 0),
+				inst(OpPushConstant, 1),
+				inst(OpCreateIntRange),
+				inst(OpSuspendVM),
+			),
+			[]Value{Int(1), Int(2)},
+		)
+
+		expectBytecode(t, `0..10 step 2`,
+			0,
+			instrs(
+				inst(OpPushConstant, 0),
+				inst(OpPushConstant, 1),
+				inst(OpPushConstant, 2),
+				inst(OpCreateSteppedRange),
+				inst(OpSuspendVM),
+			),
+			[]Value{Int(0), Int(10), Int(2)},
+		)
+	})
+
 	t.Run("object literals", func(t *testing.T) {
 		expectBytecode(t, `{}`,
 			0,
@@ -1838,6 +1863,42 @@ func TestCompileModule(t *testing.T) {
 
 }
 
+func TestCompiledFunctionLocalNames(t *testing.T) {
+	testconfig.AllowParallelization(t)
+
+	bytecode, _, err := traceCompile(t, `
+		a = 1
+		fn f(x int, y int){
+			z = (x + y)
+			return z
+		}
+	`, nil)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.Equal(t, []string{"a"}, bytecode.main.LocalNames)
+
+	var fn *CompiledFunction
+	for _, constant := range bytecode.constants {
+		if inoxFn, ok := constant.(*InoxFunction); ok {
+			fn = inoxFn.compiledFunction
+			break
+		}
+	}
+
+	if !assert.NotNil(t, fn) {
+		return
+	}
+
+	assert.Equal(t, []string{"x", "y", "z"}, fn.LocalNames)
+	assert.Equal(t, "x", fn.LocalName(0))
+	assert.Equal(t, "y", fn.LocalName(1))
+	assert.Equal(t, "z", fn.LocalName(2))
+	assert.Equal(t, "local#3", fn.LocalName(3))
+}
+
 func inst(op Opcode, operands ...int) []byte {
 	return MakeInstruction(op, operands...)
 }
@@ -1888,6 +1949,7 @@ func _expectBytecode(t *testing.T, actualBytecode *Bytecode, localCount int, exp
 			constant.symbolicValue = nil
 			if constant.compiledFunction != nil {
 				constant.compiledFunction.SourceMap = nil
+				constant.compiledFunction.LocalNames = nil
 				constant.compiledFunction.Bytecode = nil
 			}
 		case *Bytecode:
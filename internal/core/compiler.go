@@ -241,6 +241,8 @@ func (c *compiler) Compile(node parse.Node) error {
 		case parse.NotMatch:
 			c.emit(node, OpMatch)
 			c.emit(node, OpBooleanNot)
+		case parse.As:
+			c.emit(node, OpAs)
 		case parse.In:
 			c.emit(node, OpIn)
 		case parse.NotIn:
@@ -276,7 +278,14 @@ func (c *compiler) Compile(node parse.Node) error {
 		} else if err := c.Compile(node.UpperBound); err != nil {
 			return err
 		}
-		c.emit(node, OpCreateIntRange)
+		if node.Step != nil {
+			if err := c.Compile(node.Step); err != nil {
+				return err
+			}
+			c.emit(node, OpCreateSteppedRange)
+		} else {
+			c.emit(node, OpCreateIntRange)
+		}
 	case *parse.FloatRangeLiteral:
 		if err := c.Compile(node.LowerBound); err != nil {
 			return err
@@ -1643,6 +1652,7 @@ func (c *compiler) Compile(node parse.Node) error {
 
 		//leave local scope
 		localCount := c.currentLocalSymbols().SymbolCount()
+		localNames := c.currentLocalSymbols().SlotNames()
 		instructions := c.currentInstructions()
 
 		sourceMap := c.currentSourceMap()
@@ -1656,6 +1666,7 @@ func (c *compiler) Compile(node parse.Node) error {
 		compiledFunction := &CompiledFunction{
 			Instructions:   instructions,
 			LocalCount:     localCount,
+			LocalNames:     localNames,
 			ParamCount:     len(node.Parameters),
 			IsVariadic:     node.IsVariadic,
 			SourceMap:      sourceMap,
@@ -2210,6 +2221,8 @@ func (c *compiler) Compile(node parse.Node) error {
 			opCode = OpConcatStrLikes
 		case symbolic.ImplementsOrIsMultivalueWithAllValuesImplementing[*symbolic.Tuple](firstElemSymbValue):
 			opCode = OpConcatTuples
+		case symbolic.ImplementsOrIsMultivalueWithAllValuesImplementing[*symbolic.List](firstElemSymbValue):
+			opCode = OpConcatLists
 		default:
 			return fmt.Errorf("cannot compile concatenation expression: unsupported type: %s", symbolic.Stringify(firstElemSymbValue))
 		}
@@ -2670,6 +2683,32 @@ func (c *compiler) compileAssign(node *parse.Assignment, lhs, rhs parse.Node) er
 		}
 
 		c.emit(node, OpSetMember, c.addConstant(String(l.PropertyName.Name)))
+	case *parse.ComputedMemberExpression:
+		if err := c.Compile(l.Left); err != nil {
+			return err
+		}
+
+		if err := c.Compile(l.PropertyName); err != nil {
+			return err
+		}
+
+		if node.Operator != parse.Assign {
+			if err := c.Compile(l.Left); err != nil {
+				return err
+			}
+
+			if err := c.Compile(l.PropertyName); err != nil {
+				return err
+			}
+
+			c.emit(node, OpComputedMemb)
+		}
+
+		if err := c.compileAssignOperation(node, rhs); err != nil {
+			return err
+		}
+
+		c.emit(node, OpSetComputedMember)
 	case *parse.IndexExpression:
 		if err := c.Compile(l.Indexed); err != nil {
 			return err
@@ -2890,6 +2929,7 @@ func (c *compiler) compileMainChunk(chunk *parse.ParsedChunkSource) (*Bytecode,
 	instructions := c.currentInstructions()
 	srcMap := c.currentSourceMap()
 	localCount := c.currentLocalSymbols().SymbolCount()
+	localNames := c.currentLocalSymbols().SlotNames()
 	c.scopes = c.scopes[:len(c.scopes)-1]
 	c.localSymbolTableStack = c.localSymbolTableStack[:len(c.localSymbolTableStack)-1]
 	c.scopeIndex--
@@ -2907,6 +2947,7 @@ func (c *compiler) compileMainChunk(chunk *parse.ParsedChunkSource) (*Bytecode,
 		LocalCount:   0,
 	}
 	main.LocalCount = localCount
+	main.LocalNames = localNames
 
 	if len(c.constants) > math.MaxUint16 {
 		panic("invalid constant count")
@@ -48,6 +48,7 @@ var (
 
 	ErrLimitNotPresentInContext   = errors.New("limit not present in context")
 	ErrOnDoneMicrotasksNotAllowed = errors.New("'on done' microtasks are not allowed")
+	ErrOperationTimeout           = errors.New("operation timeout")
 )
 
 // A Context is analogous to contexts provided by the stdlib's `context` package.
@@ -101,6 +102,7 @@ type Context struct {
 	typeExtensions      []*TypeExtension
 
 	executionStartTime time.Time
+	operationTimeout   time.Duration //zero if individual operations have no timeout
 
 	tempDir           Path //directory for storing temporary files, defaults to a random directory in /tmp
 	waitConfirmPrompt WaitConfirmPrompt
@@ -155,6 +157,10 @@ type ContextConfig struct {
 	DoNotSetFilesystemContext bool
 	InitialWorkingDirectory   Path //if not set defaults to '/'
 
+	//timeout of individual operations such as filesystem calls (e.g. listing the files of a directory),
+	//if not set the timeout of the parent context is inherited. Zero means no timeout.
+	OperationTimeout time.Duration
+
 	// if false a goroutine is created to tear down the context after it is done.
 	// if true IsDone() will always return false until CancelGracefully is called.
 	DoNotSpawnDoneGoroutine bool
@@ -238,6 +244,7 @@ func NewContext(config ContextConfig) *Context {
 		cancel                  context.CancelFunc
 		filesystem              afs.Filesystem = config.Filesystem
 		initialWorkingDirectory Path           = config.InitialWorkingDirectory
+		operationTimeout                       = config.OperationTimeout
 		ctx                                    = &Context{} //the context is initialized later in the function but we need the address
 	)
 
@@ -355,6 +362,10 @@ func NewContext(config ContextConfig) *Context {
 			filesystem = parentCtx.fs
 			initialWorkingDirectory = parentCtx.initialWorkingDirectory
 		}
+
+		if operationTimeout == 0 {
+			operationTimeout = parentCtx.operationTimeout
+		}
 	}
 
 	limits := make([]Limit, 0)
@@ -388,6 +399,7 @@ func NewContext(config ContextConfig) *Context {
 		fs:                      actualFilesystem,
 		initialWorkingDirectory: initialWorkingDirectory,
 		executionStartTime:      time.Now(),
+		operationTimeout:        operationTimeout,
 		grantedPermissions:      slices.Clone(config.Permissions),
 		forbiddenPermissions:    slices.Clone(config.ForbiddenPermissions),
 		limits:                  limits,
@@ -687,6 +699,12 @@ func (ctx *Context) InitialWorkingDirectory() Path {
 	return ctx.initialWorkingDirectory
 }
 
+// TimeoutForOperation returns the timeout of individual operations such as filesystem calls,
+// the boolean result is false if there is no timeout.
+func (ctx *Context) TimeoutForOperation() (time.Duration, bool) {
+	return ctx.operationTimeout, ctx.operationTimeout > 0
+}
+
 func (ctx *Context) SetWaitConfirmPrompt(fn WaitConfirmPrompt) {
 	ctx.lock.Lock()
 	defer ctx.lock.Unlock()
@@ -725,6 +743,32 @@ func (ctx *Context) hasPermission(perm Permission) bool {
 	return false
 }
 
+// HasAnyPermission returns true if at least one of the passed permissions is present in the Context, see HasPermission.
+func (ctx *Context) HasAnyPermission(perms ...Permission) bool {
+	ctx.lock.RLock()
+	defer ctx.lock.RUnlock()
+
+	for _, perm := range perms {
+		if ctx.hasPermission(perm) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasAllPermissions returns true if all the passed permissions are present in the Context, see HasPermission.
+func (ctx *Context) HasAllPermissions(perms ...Permission) bool {
+	ctx.lock.RLock()
+	defer ctx.lock.RUnlock()
+
+	for _, perm := range perms {
+		if !ctx.hasPermission(perm) {
+			return false
+		}
+	}
+	return true
+}
+
 // THIS FUNCTION SHOULD NEVER BE USED apart from the symbolic package
 func (ctx *Context) HasPermissionUntyped(perm any) bool {
 	ctx.lock.RLock()
@@ -805,6 +849,36 @@ func (ctx *Context) boundChild(opts BoundChildContextOptions) *Context {
 	return child
 }
 
+// WithAdditionalGrantedPermissions creates a bound child of the context (see BoundChild) that is granted the passed
+// permissions in addition to the permissions of the context, the context is not modified. The forbidden permissions of
+// the context are inherited by the child: permissions included by a forbidden permission are not granted. If the context
+// has a parent, permissions that are not granted by the parent are not granted either.
+func (ctx *Context) WithAdditionalGrantedPermissions(perms []Permission) *Context {
+	child := ctx.boundChild(BoundChildContextOptions{})
+
+	child.lock.Lock()
+	defer child.lock.Unlock()
+
+top:
+	for _, perm := range perms {
+		for _, forbiddenPerm := range child.forbiddenPermissions {
+			if forbiddenPerm.Includes(perm) {
+				continue top
+			}
+		}
+
+		if ctx.parentCtx != nil && !ctx.parentCtx.HasPermission(perm) {
+			continue
+		}
+
+		if !child.hasPermission(perm) {
+			child.grantedPermissions = append(child.grantedPermissions, perm)
+		}
+	}
+
+	return child
+}
+
 // New creates a new context with the same permissions, limits, host data, patterns, aliases & protocol clients,
 // if the context has no parent the token counts are copied, the new context does not "share" data with the older context.
 func (ctx *Context) New() *Context {
@@ -829,6 +903,7 @@ func (ctx *Context) New() *Context {
 		TypeExtensions:       ctx.typeExtensions,
 		ParentContext:        ctx.parentCtx,
 		Filesystem:           ctx.fs,
+		OperationTimeout:     ctx.operationTimeout,
 
 		WaitConfirmPrompt: ctx.waitConfirmPrompt,
 	})
@@ -1036,6 +1111,19 @@ func (ctx *Context) GetGrantedPermissions() []Permission {
 	return slices.Clone(ctx.grantedPermissions)
 }
 
+// ForEachGrantedPermission calls fn for each granted permission without copying them, the iteration stops
+// if fn returns false. fn should not modify the context.
+func (ctx *Context) ForEachGrantedPermission(fn func(perm Permission) bool) {
+	ctx.lock.RLock()
+	defer ctx.lock.RUnlock()
+
+	for _, perm := range ctx.grantedPermissions {
+		if !fn(perm) {
+			return
+		}
+	}
+}
+
 func (ctx *Context) GetForbiddenPermissions() []Permission {
 	ctx.lock.RLock()
 	defer ctx.lock.RUnlock()
@@ -1086,6 +1174,19 @@ func (ctx *Context) GetNamedPatterns() map[string]Pattern {
 	return maps.Clone(ctx.namedPatterns)
 }
 
+// AllNamedPatternNamesSorted returns the names of all named patterns in lexicographic order.
+func (ctx *Context) AllNamedPatternNamesSorted() []string {
+	ctx.lock.RLock()
+	defer ctx.lock.RUnlock()
+
+	names := make([]string, 0, len(ctx.namedPatterns))
+	for name := range ctx.namedPatterns {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
 func (ctx *Context) ForEachNamedPattern(fn func(name string, pattern Pattern) error) error {
 	ctx.lock.RLock()
 	defer ctx.lock.RUnlock()
@@ -1209,10 +1310,7 @@ func (ctx *Context) GetProtolClient(u URL) (ProtocolClient, error) {
 }
 
 func (ctx *Context) GetHostDefinition(h Host) Value {
-	ctx.lock.RLock()
-	defer ctx.lock.RUnlock()
-
-	v, ok := ctx.hostDefinitions[h]
+	v, ok := ctx.LookupHostDefinition(h)
 	if !ok {
 		return Nil
 	}
@@ -1220,6 +1318,15 @@ func (ctx *Context) GetHostDefinition(h Host) Value {
 	return v
 }
 
+// LookupHostDefinition returns the definition of a single host, the boolean result is false if the host is not defined.
+func (ctx *Context) LookupHostDefinition(h Host) (Value, bool) {
+	ctx.lock.RLock()
+	defer ctx.lock.RUnlock()
+
+	v, ok := ctx.hostDefinitions[h]
+	return v, ok
+}
+
 func (ctx *Context) GetHostByDefinition(r ResourceName) (Host, bool) {
 	ctx.lock.RLock()
 	defer ctx.lock.RUnlock()
@@ -1307,6 +1414,25 @@ func (ctx *Context) GetTypeExtension(id string) *TypeExtension {
 	return nil
 }
 
+// ExtensionsFor returns the extensions whose extended pattern matches $v, the result is sorted by extension id.
+func (ctx *Context) ExtensionsFor(v Value) []*TypeExtension {
+	ctx.lock.RLock()
+	typeExtensions := slices.Clone(ctx.typeExtensions)
+	ctx.lock.RUnlock()
+
+	var extensions []*TypeExtension
+	for _, ext := range typeExtensions {
+		if ext.extendedPattern.Test(ctx, v) {
+			extensions = append(extensions, ext)
+		}
+	}
+
+	slices.SortStableFunc(extensions, func(a, b *TypeExtension) int {
+		return strings.Compare(a.Id(), b.Id())
+	})
+	return extensions
+}
+
 func (ctx *Context) AddTypeExtension(extension *TypeExtension) {
 	ctx.lock.Lock()
 	defer ctx.lock.Unlock()
@@ -11,6 +11,7 @@ import (
 	"time"
 
 	permkind "github.com/inoxlang/inox/internal/core/permkind"
+	"github.com/inoxlang/inox/internal/core/symbolic"
 	"github.com/inoxlang/inox/internal/utils"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/assert"
@@ -416,6 +417,46 @@ func TestContextForbiddenPermissions(t *testing.T) {
 	assert.False(t, ctx.HasPermission(readFile))
 }
 
+func TestContextForEachGrantedPermission(t *testing.T) {
+	readGoFiles := FilesystemPermission{permkind.Read, PathPattern("./*.go")}
+	readTxtFiles := FilesystemPermission{permkind.Read, PathPattern("./*.txt")}
+	readFile := FilesystemPermission{permkind.Read, Path("./file.go")}
+
+	ctx := NewContextWithEmptyState(ContextConfig{
+		Permissions: []Permission{readGoFiles, readTxtFiles, readFile},
+	}, nil)
+	defer ctx.CancelGracefully()
+
+	t.Run("all permissions", func(t *testing.T) {
+		var perms []Permission
+		ctx.ForEachGrantedPermission(func(perm Permission) bool {
+			perms = append(perms, perm)
+			return true
+		})
+		assert.Equal(t, ctx.GetGrantedPermissions(), perms)
+	})
+
+	t.Run("early termination", func(t *testing.T) {
+		callCount := 0
+		ctx.ForEachGrantedPermission(func(perm Permission) bool {
+			callCount++
+			return callCount < 2
+		})
+		assert.Equal(t, 2, callCount)
+	})
+}
+
+func TestContextAllNamedPatternNamesSorted(t *testing.T) {
+	ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+	defer ctx.CancelGracefully()
+
+	ctx.AddNamedPattern("int", INT_PATTERN)
+	ctx.AddNamedPattern("bool", BOOL_PATTERN)
+	ctx.AddNamedPattern("str", STR_PATTERN)
+
+	assert.Equal(t, []string{"bool", "int", "str"}, ctx.AllNamedPatternNamesSorted())
+}
+
 func TestContextDropPermissions(t *testing.T) {
 	readGoFiles := FilesystemPermission{permkind.Read, PathPattern("./*.go")}
 	readFile := FilesystemPermission{permkind.Read, Path("./file.go")}
@@ -432,6 +473,120 @@ func TestContextDropPermissions(t *testing.T) {
 	assert.False(t, ctx.HasPermission(readFile))
 }
 
+func TestContextHasAnyAllPermissions(t *testing.T) {
+	readGoFiles := FilesystemPermission{permkind.Read, PathPattern("./*.go")}
+	readTxtFiles := FilesystemPermission{permkind.Read, PathPattern("./*.txt")}
+	readGoFile := FilesystemPermission{permkind.Read, Path("./file.go")}
+	readForbiddenGoFile := FilesystemPermission{permkind.Read, Path("./forbidden.go")}
+
+	t.Run("no granted permissions", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		assert.False(t, ctx.HasAnyPermission(readGoFiles, readTxtFiles))
+		assert.False(t, ctx.HasAllPermissions(readGoFiles, readTxtFiles))
+	})
+
+	t.Run("some granted permissions", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{
+			Permissions: []Permission{readGoFiles},
+		}, nil)
+		defer ctx.CancelGracefully()
+
+		assert.True(t, ctx.HasAnyPermission(readTxtFiles, readGoFile))
+		assert.False(t, ctx.HasAllPermissions(readTxtFiles, readGoFile))
+		assert.True(t, ctx.HasAllPermissions(readGoFiles, readGoFile))
+	})
+
+	t.Run("all granted permissions", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{
+			Permissions: []Permission{readGoFiles, readTxtFiles},
+		}, nil)
+		defer ctx.CancelGracefully()
+
+		assert.True(t, ctx.HasAnyPermission(readGoFiles, readTxtFiles))
+		assert.True(t, ctx.HasAllPermissions(readGoFiles, readTxtFiles))
+	})
+
+	t.Run("forbidden permission", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{
+			Permissions:          []Permission{readGoFiles},
+			ForbiddenPermissions: []Permission{readForbiddenGoFile},
+		}, nil)
+		defer ctx.CancelGracefully()
+
+		assert.False(t, ctx.HasAnyPermission(readForbiddenGoFile))
+		assert.True(t, ctx.HasAnyPermission(readForbiddenGoFile, readGoFile))
+		assert.False(t, ctx.HasAllPermissions(readForbiddenGoFile, readGoFile))
+	})
+
+	t.Run("no permissions passed", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		assert.False(t, ctx.HasAnyPermission())
+		assert.True(t, ctx.HasAllPermissions())
+	})
+}
+
+func TestContextWithAdditionalGrantedPermissions(t *testing.T) {
+	readGoFiles := FilesystemPermission{permkind.Read, PathPattern("./*.go")}
+	readTxtFiles := FilesystemPermission{permkind.Read, PathPattern("./*.txt")}
+	readFile := FilesystemPermission{permkind.Read, Path("./file.txt")}
+
+	t.Run("base case", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{
+			Permissions: []Permission{readGoFiles},
+		}, nil)
+		defer ctx.CancelGracefully()
+
+		child := ctx.WithAdditionalGrantedPermissions([]Permission{readTxtFiles})
+
+		assert.True(t, child.HasPermission(readGoFiles))
+		assert.True(t, child.HasPermission(readTxtFiles))
+
+		//the parent should not be modified.
+		assert.True(t, ctx.HasPermission(readGoFiles))
+		assert.False(t, ctx.HasPermission(readTxtFiles))
+		assert.Equal(t, []Permission{readGoFiles}, ctx.GetGrantedPermissions())
+	})
+
+	t.Run("forbidden permissions should not be granted", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{
+			Permissions:          []Permission{readGoFiles},
+			ForbiddenPermissions: []Permission{readFile},
+		}, nil)
+		defer ctx.CancelGracefully()
+
+		child := ctx.WithAdditionalGrantedPermissions([]Permission{readFile, readTxtFiles})
+
+		assert.False(t, child.HasPermission(readFile))
+		assert.True(t, child.HasPermission(readTxtFiles))
+		assert.NotContains(t, child.GetGrantedPermissions(), readFile)
+	})
+
+	t.Run("permissions not granted by the parent of the context should not be granted", func(t *testing.T) {
+		parent := NewContextWithEmptyState(ContextConfig{
+			Permissions: []Permission{readGoFiles, readTxtFiles},
+		}, nil)
+		defer parent.CancelGracefully()
+
+		ctx := NewContext(ContextConfig{
+			Permissions:   []Permission{readGoFiles},
+			ParentContext: parent,
+		})
+		defer ctx.CancelGracefully()
+
+		readAnyFile := FilesystemPermission{permkind.Read, PathPattern("/...")}
+
+		child := ctx.WithAdditionalGrantedPermissions([]Permission{readTxtFiles, readAnyFile})
+
+		assert.True(t, child.HasPermission(readTxtFiles))
+		assert.False(t, child.HasPermission(readAnyFile))
+		assert.NotContains(t, child.GetGrantedPermissions(), readAnyFile)
+	})
+}
+
 func TestContextLimiters(t *testing.T) {
 	{
 		runtime.GC()
@@ -1083,3 +1238,50 @@ func TestContextPutResolveUserData(t *testing.T) {
 		}()
 	})
 }
+
+func TestContextExtensionsFor(t *testing.T) {
+	ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+	defer ctx.CancelGracefully()
+
+	intExtension := &TypeExtension{
+		extendedPattern:   INT_PATTERN,
+		symbolicExtension: &symbolic.TypeExtension{Id: "b"},
+	}
+	serializableExtension := &TypeExtension{
+		extendedPattern:   SERIALIZABLE_PATTERN,
+		symbolicExtension: &symbolic.TypeExtension{Id: "a"},
+	}
+	strExtension := &TypeExtension{
+		extendedPattern:   STR_PATTERN,
+		symbolicExtension: &symbolic.TypeExtension{Id: "c"},
+	}
+
+	ctx.AddTypeExtension(intExtension)
+	ctx.AddTypeExtension(serializableExtension)
+	ctx.AddTypeExtension(strExtension)
+
+	assert.Equal(t, []*TypeExtension{serializableExtension, intExtension}, ctx.ExtensionsFor(Int(1)))
+	assert.Equal(t, []*TypeExtension{serializableExtension, strExtension}, ctx.ExtensionsFor(String("a")))
+	assert.Equal(t, []*TypeExtension{serializableExtension}, ctx.ExtensionsFor(True))
+}
+
+func TestContextLookupHostDefinition(t *testing.T) {
+	ctx := NewContextWithEmptyState(ContextConfig{
+		HostDefinitions: map[Host]Value{
+			"ldb://db1": Path("/tmp/db1/"),
+		},
+	}, nil)
+	defer ctx.CancelGracefully()
+
+	t.Run("defined host", func(t *testing.T) {
+		definition, ok := ctx.LookupHostDefinition("ldb://db1")
+		assert.True(t, ok)
+		assert.Equal(t, Path("/tmp/db1/"), definition)
+	})
+
+	t.Run("undefined host", func(t *testing.T) {
+		definition, ok := ctx.LookupHostDefinition("ldb://db2")
+		assert.False(t, ok)
+		assert.Nil(t, definition)
+	})
+}
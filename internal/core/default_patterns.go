@@ -47,6 +47,7 @@ var (
 
 			return NewExactValuePattern(values[0]), nil
 		},
+		SymbolicCallArity: &symbolic.CallArity{Min: 1, Max: 1},
 		SymbolicCallImpl: func(ctx *symbolic.Context, values []symbolic.Value) (symbolic.Pattern, error) {
 			var recordPattern *symbolic.RecordPattern
 
@@ -87,6 +88,7 @@ var (
 
 			return stringPattern, nil
 		},
+		SymbolicCallArity: &symbolic.CallArity{Min: 1, Max: 1},
 		SymbolicCallImpl: func(ctx *symbolic.Context, values []symbolic.Value) (symbolic.Pattern, error) {
 			if len(values) != 1 {
 				return nil, commonfmt.FmtErrNArgumentsExpected("1")
@@ -372,6 +374,7 @@ var (
 				intRange: intRange,
 			}, nil
 		},
+		SymbolicCallArity: &symbolic.CallArity{Min: 1, Max: 1},
 		SymbolicCallImpl: func(ctx *symbolic.Context, values []symbolic.Value) (symbolic.Pattern, error) {
 			if len(values) == 0 {
 				return nil, errors.New("missing argument")
@@ -426,6 +429,7 @@ var (
 				floatRange: floatRange,
 			}, nil
 		},
+		SymbolicCallArity: &symbolic.CallArity{Min: 1, Max: 1},
 		SymbolicCallImpl: func(ctx *symbolic.Context, values []symbolic.Value) (symbolic.Pattern, error) {
 			if len(values) == 0 {
 				return nil, errors.New("missing argument")
@@ -463,6 +467,7 @@ var (
 
 			return NewOptionalPattern(ctx, pattern)
 		},
+		SymbolicCallArity: &symbolic.CallArity{Min: 1, Max: 1},
 		SymbolicCallImpl: func(ctx *symbolic.Context, values []symbolic.Value) (symbolic.Pattern, error) {
 			if len(values) == 0 {
 				return nil, errors.New("missing argument")
@@ -709,6 +714,7 @@ var (
 
 			return NewMutationPattern(kind, data0Pattern), nil
 		},
+		SymbolicCallArity: &symbolic.CallArity{Min: 1, Max: 2},
 		SymbolicCallImpl: func(ctx *symbolic.Context, args []symbolic.Value) (symbolic.Pattern, error) {
 			switch len(args) {
 			case 2:
@@ -3,9 +3,11 @@ package core
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 
 	"github.com/inoxlang/inox/internal/core/symbolic"
+	"golang.org/x/exp/maps"
 )
 
 // A Dictionnary maps representable values (keys) to any values, Dictionar implements Value.
@@ -66,6 +68,19 @@ func (d *Dictionary) ForEachEntry(ctx *Context, fn func(keyRepr string, key Seri
 	return nil
 }
 
+// ForEachEntrySorted is like ForEachEntry but iterates over the entries in the lexicographic order of the key representations.
+func (d *Dictionary) ForEachEntrySorted(ctx *Context, fn func(keyRepr string, key Serializable, v Serializable) error) error {
+	keyReprs := maps.Keys(d.entries)
+	sort.Strings(keyReprs)
+
+	for _, keyRepr := range keyReprs {
+		if err := fn(keyRepr, d.keys[keyRepr], d.entries[keyRepr]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (d *Dictionary) getKeyRepr(ctx *Context, key Serializable) string {
 	return MustGetJSONRepresentationWithConfig(key, ctx, JSONSerializationConfig{ReprConfig: ALL_VISIBLE_REPR_CONFIG})
 }
@@ -0,0 +1,35 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDictionaryForEachEntrySorted(t *testing.T) {
+	dict := NewDictionary(map[string]Serializable{
+		`"c"`: Int(3),
+		`"a"`: Int(1),
+		`"d"`: Int(4),
+		`"b"`: Int(2),
+	})
+
+	for i := 0; i < 10; i++ {
+		var keyReprs []string
+		var values []Serializable
+
+		err := dict.ForEachEntrySorted(nil, func(keyRepr string, key, v Serializable) error {
+			assert.Equal(t, dict.keys[keyRepr], key)
+			keyReprs = append(keyReprs, keyRepr)
+			values = append(values, v)
+			return nil
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, []string{`"a"`, `"b"`, `"c"`, `"d"`}, keyReprs)
+		assert.Equal(t, []Serializable{Int(1), Int(2), Int(3), Int(4)}, values)
+	}
+}
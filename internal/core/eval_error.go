@@ -21,15 +21,17 @@ const (
 )
 
 var (
-	ErrStackOverflow            = errors.New("stack overflow")
-	ErrIndexOutOfRange          = errors.New("index out of range")
-	ErrInsertionIndexOutOfRange = errors.New("insertion index out of range")
-	ErrNegativeLowerIndex       = errors.New("negative lower index")
-	ErrUnreachable              = errors.New("unreachable")
+	ErrStackOverflow             = errors.New("stack overflow")
+	ErrInstructionBudgetExceeded = errors.New("instruction budget exceeded")
+	ErrIndexOutOfRange           = errors.New("index out of range")
+	ErrInsertionIndexOutOfRange  = errors.New("insertion index out of range")
+	ErrNegativeLowerIndex        = errors.New("negative lower index")
+	ErrUnreachable               = errors.New("unreachable")
 
 	ErrCannotSetValOfIndexKeyProp = errors.New("cannot set value of index key property")
 	ErrCannotPopFromEmptyList     = errors.New("cannot pop from an empty list")
 	ErrCannotDequeueFromEmptyList = errors.New("cannot dequeue from an empty list")
+	ErrInvalidChunkSize           = errors.New("chunk size should be greater than zero")
 
 	//integer
 	ErrIntOverflow          = errors.New("integer overflow")
@@ -71,6 +73,8 @@ var (
 	ErrNotEnoughCliArgs                 = errors.New("not enough CLI arguments")
 	ErrMissinggRuntimeTypecheckSymbData = errors.New("impossible to perform runtime typecheck because symbolic data is missing")
 	ErrPrecisionLoss                    = errors.New("precision loss")
+	ErrAsConversionFailed               = errors.New("conversion failed")
+	ErrRightOperandOfAsNotPattern       = errors.New("the right operand of an 'as' binary expression should be a pattern")
 
 	ErrValueInExactPatternValueShouldBeImmutable = errors.New("the value in an exact value pattern should be immutable")
 
@@ -88,6 +92,10 @@ func FormatRuntimeTypeCheckFailed(pattern Pattern, ctx *Context) error {
 	return fmt.Errorf("runtime type check failed: value does not match the pattern %s", Stringify(pattern, ctx))
 }
 
+func FormatAsConversionFailed(pattern Pattern, ctx *Context) error {
+	return fmt.Errorf("%w: value does not match the pattern %s", ErrAsConversionFailed, Stringify(pattern, ctx))
+}
+
 func fmtTooManyPositionalArgs(positionalArgCount, positionalParamCount int) string {
 	return fmt.Sprintf("too many positional arguments were provided (%d), at most %d positional arguments are expected", positionalArgCount, positionalParamCount)
 }
@@ -1262,6 +1262,10 @@ func testEval(t *testing.T, bytecodeEval bool, Eval evalFn) {
 			{`({a: 1} match %{a: 1})`, True, nil},
 			{`({} match %{a: 1})`, False, nil},
 
+			{`(1 as %int)`, Int(1), nil},
+			{`("1" as %int)`, nil, ErrAsConversionFailed},
+			{`(1 as 1)`, nil, ErrRightOperandOfAsNotPattern},
+
 			{`("a" keyof {})`, False, nil},
 			{`("a" keyof {a: 1})`, True, nil},
 			{`("aa" keyof {"a": "aa"})`, False, nil},
@@ -1712,6 +1716,24 @@ func testEval(t *testing.T, bytecodeEval bool, Eval evalFn) {
 				`,
 				error: true,
 			},
+			{
+				input: `
+					a = {}
+					name = "v"
+					a.(name) = 1
+					return $a
+				`,
+				result: objFrom(ValMap{"v": Int(1)}),
+			},
+			{
+				input: `
+					a = {v: 1}
+					name = "v"
+					a.(name) += 1
+					return $a
+				`,
+				result: objFrom(ValMap{"v": Int(2)}),
+			},
 			{
 				input: `
 					struct MyStruct {
@@ -2565,6 +2587,19 @@ func testEval(t *testing.T, bytecodeEval bool, Eval evalFn) {
 			assert.NoError(t, err)
 			assert.EqualValues(t, newList(&ValueList{elements: []Serializable{Int(1), Nil}}), res)
 		})
+
+		t.Run("pair", func(t *testing.T) {
+			code := `
+				assign a b = (1, "a")
+				return [$a, $b]
+			`
+			state := NewGlobalState(NewDefaultTestContext())
+			defer state.Ctx.CancelGracefully()
+			res, err := Eval(code, state, true)
+
+			assert.NoError(t, err)
+			assert.EqualValues(t, newList(&ValueList{elements: []Serializable{Int(1), String("a")}}), res)
+		})
 	})
 
 	t.Run("if statement", func(t *testing.T) {
@@ -2752,6 +2787,19 @@ func testEval(t *testing.T, bytecodeEval bool, Eval evalFn) {
 				result:          newList(&ValueList{elements: []Serializable{Int(1), Int(11)}}),
 				doSymbolicCheck: true,
 			},
+			{
+				input: `
+				c1 = 0
+				c2 = 0
+				for i, e in 0..10 step 2 {
+					c1 = ($c1 + $i)
+					c2 = ($c2 + $e)
+				}
+				return [$c1, $c2]
+			`,
+				result:          newList(&ValueList{elements: []Serializable{Int(15), Int(30)}}),
+				doSymbolicCheck: true,
+			},
 			{
 				input: `
 				c1 = 0
@@ -3674,6 +3722,21 @@ func testEval(t *testing.T, bytecodeEval bool, Eval evalFn) {
 				step:         1,
 			}, res)
 		})
+
+		t.Run("with step", func(t *testing.T) {
+			code := `return 0..10 step 2`
+			state := NewGlobalState(NewDefaultTestContext())
+			defer state.Ctx.CancelGracefully()
+			res, err := Eval(code, state, false)
+
+			assert.NoError(t, err)
+			assert.Equal(t, IntRange{
+				unknownStart: false,
+				start:        0,
+				end:          10,
+				step:         2,
+			}, res)
+		})
 	})
 
 	t.Run("float range literal ", func(t *testing.T) {
@@ -7437,6 +7500,26 @@ func testEval(t *testing.T, bytecodeEval bool, Eval evalFn) {
 			assert.Equal(t, NewTuple([]Serializable{Int(1), String("a")}), res)
 		})
 
+		t.Run("two lists", func(t *testing.T) {
+			code := `
+				list = [1]
+				result = concat list [2, 3]
+				list.append(4)
+				return [list, result]
+			`
+			state := NewGlobalState(NewDefaultTestContext(), map[string]Value{})
+			defer state.Ctx.CancelGracefully()
+
+			res, err := Eval(code, state, true)
+
+			if !assert.NoError(t, err) {
+				return
+			}
+			lists := res.(*List).GetOrBuildElements(state.Ctx)
+			assert.Equal(t, []Serializable{Int(1), Int(4)}, lists[0].(*List).GetOrBuildElements(state.Ctx))
+			assert.Equal(t, []Serializable{Int(1), Int(2), Int(3)}, lists[1].(*List).GetOrBuildElements(state.Ctx))
+		})
+
 		t.Run("string element followed by a spread element with a single item", func(t *testing.T) {
 			code := `concat "a" ...["b"]`
 			state := NewGlobalState(NewDefaultTestContext())
@@ -7975,6 +8058,140 @@ func testEval(t *testing.T, bytecodeEval bool, Eval evalFn) {
 				}, res)
 			})
 
+			t.Run("record pattern", func(t *testing.T) {
+				code := `
+					pattern user = #{name: "foo"}
+					return %{...%user}
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &ObjectPattern{
+					inexact: true,
+					entries: []ObjectPatternEntry{
+						{
+							Name:    "name",
+							Pattern: NewExactStringPattern(String("foo")),
+						},
+					},
+				}, res)
+			})
+
+			t.Run("inexact object pattern in exact object pattern", func(t *testing.T) {
+				code := `
+					pattern user = %{name: "foo"}
+					return %{...%user, otherprops(no)}
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &ObjectPattern{
+					inexact: true,
+					entries: []ObjectPatternEntry{
+						{
+							Name:    "name",
+							Pattern: NewExactStringPattern(String("foo")),
+						},
+					},
+				}, res)
+			})
+
+			t.Run("exact object pattern in exact object pattern", func(t *testing.T) {
+				code := `
+					pattern user = %{name: "foo", otherprops(no)}
+					return %{...%user, otherprops(no)}
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &ObjectPattern{
+					inexact: false,
+					entries: []ObjectPatternEntry{
+						{
+							Name:    "name",
+							Pattern: NewExactStringPattern(String("foo")),
+						},
+					},
+				}, res)
+			})
+
+			t.Run("record pattern with an optional entry", func(t *testing.T) {
+				code := `
+					pattern user = #{name?: "foo"}
+					return %{...%user}
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &ObjectPattern{
+					inexact:            true,
+					optionalEntryCount: 1,
+					entries: []ObjectPatternEntry{
+						{
+							Name:       "name",
+							Pattern:    NewExactStringPattern(String("foo")),
+							IsOptional: true,
+						},
+					},
+				}, res)
+			})
+
+			t.Run("inexact record pattern in exact object pattern", func(t *testing.T) {
+				code := `
+					pattern user = #{name: "foo"}
+					return %{...%user, otherprops(no)}
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &ObjectPattern{
+					inexact: true,
+					entries: []ObjectPatternEntry{
+						{
+							Name:    "name",
+							Pattern: NewExactStringPattern(String("foo")),
+						},
+					},
+				}, res)
+			})
+
+			t.Run("exact record pattern in exact object pattern", func(t *testing.T) {
+				code := `
+					pattern user = #{name: "foo", otherprops(no)}
+					return %{...%user, otherprops(no)}
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &ObjectPattern{
+					inexact: false,
+					entries: []ObjectPatternEntry{
+						{
+							Name:    "name",
+							Pattern: NewExactStringPattern(String("foo")),
+						},
+					},
+				}, res)
+			})
+
 			t.Run("spread element is not an object pattern", func(t *testing.T) {
 				code := `pattern s = "s"; return %{...%s}`
 
@@ -8171,6 +8388,100 @@ func testEval(t *testing.T, bytecodeEval bool, Eval evalFn) {
 				}, res)
 			})
 
+			t.Run("object pattern with entries matching immutable values", func(t *testing.T) {
+				code := `
+					pattern user = {name: "foo"}
+					pattern p = #{...%user}
+					return %p
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &RecordPattern{
+					inexact: true,
+					entries: []RecordPatternEntry{
+						{
+							Name:    "name",
+							Pattern: NewExactStringPattern(String("foo")),
+						},
+					},
+				}, res)
+			})
+
+			t.Run("object pattern with an optional entry", func(t *testing.T) {
+				code := `
+					pattern user = {name?: "foo"}
+					pattern p = #{...%user}
+					return %p
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &RecordPattern{
+					inexact:            true,
+					optionalEntryCount: 1,
+					entries: []RecordPatternEntry{
+						{
+							Name:       "name",
+							Pattern:    NewExactStringPattern(String("foo")),
+							IsOptional: true,
+						},
+					},
+				}, res)
+			})
+
+			t.Run("inexact object pattern in exact record pattern", func(t *testing.T) {
+				code := `
+					pattern user = {name: "foo"}
+					pattern p = #{...%user, otherprops(no)}
+					return %p
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &RecordPattern{
+					inexact: true,
+					entries: []RecordPatternEntry{
+						{
+							Name:    "name",
+							Pattern: NewExactStringPattern(String("foo")),
+						},
+					},
+				}, res)
+			})
+
+			t.Run("exact object pattern in exact record pattern", func(t *testing.T) {
+				code := `
+					pattern user = {name: "foo", otherprops(no)}
+					pattern p = #{...%user, otherprops(no)}
+					return %p
+				`
+
+				state := NewGlobalState(NewDefaultTestContext())
+				defer state.Ctx.CancelGracefully()
+				res, err := Eval(code, state, false)
+
+				assert.NoError(t, err)
+				assert.Equal(t, &RecordPattern{
+					inexact: false,
+					entries: []RecordPatternEntry{
+						{
+							Name:    "name",
+							Pattern: NewExactStringPattern(String("foo")),
+						},
+					},
+				}, res)
+			})
+
 			t.Run("spread element is not an record pattern", func(t *testing.T) {
 				code := `pattern s = "s"; pattern p = #{...%s}; return %p`
 
@@ -9,6 +9,28 @@ import (
 	"github.com/inoxlang/inox/internal/core/symbolic"
 )
 
+func init() {
+	RegisterSymbolicGoFunctions([]any{
+		Reverse, func(ctx *symbolic.Context, list *symbolic.List) *symbolic.List {
+			if !list.HasKnownLen() {
+				return symbolic.NewListOf(symbolic.AsSerializableChecked(list.Element()))
+			}
+
+			elements := make([]symbolic.Serializable, list.KnownLen())
+			for i := range elements {
+				elements[len(elements)-1-i] = symbolic.AsSerializableChecked(list.ElementAt(i))
+			}
+			return symbolic.NewList(elements...)
+		},
+		Dedup, func(ctx *symbolic.Context, list *symbolic.List) *symbolic.List {
+			if list.HasKnownLen() && list.KnownLen() == 0 {
+				return symbolic.NewList()
+			}
+			return symbolic.NewListOf(symbolic.AsSerializableChecked(list.Element()))
+		},
+	})
+}
+
 var _ = []Indexable{
 	(*String)(nil), (*Array)(nil), (*List)(nil), (*Tuple)(nil), (*RuneSlice)(nil), (*ByteSlice)(nil),
 	(*IntRange)(nil), (*RuneRange)(nil), (*OrderedPair)(nil), KeyList{},
@@ -93,6 +115,41 @@ func (list *List) GetOrBuildElements(ctx *Context) []Serializable {
 	return values
 }
 
+// ConcatLists returns a new list containing the elements of all the lists.
+func ConcatLists(ctx *Context, lists ...*List) *List {
+	elements := make([]Serializable, 0, len(lists))
+
+	for _, l := range lists {
+		elements = append(elements, l.GetOrBuildElements(ctx)...)
+	}
+
+	return NewWrappedValueListFrom(elements)
+}
+
+// Reverse returns a new list containing the elements of list in reverse order,
+// the underlying list of the result has the same kind as the underlying list of list.
+func Reverse(ctx *Context, list *List) *List {
+	var reversed underlyingList
+
+	switch listCopy := list.underlyingList.slice(0, list.Len()).(type) {
+	case *List:
+		reversed = listCopy.underlyingList
+	case underlyingList:
+		reversed = listCopy
+	default:
+		panic(ErrUnreachable)
+	}
+
+	reversed.reverse(ctx)
+	return WrapUnderlyingList(reversed)
+}
+
+// Dedup returns a new list containing the elements of list without duplicates, the first occurrence of each element is kept.
+// The underlying list of the result has the same kind as the underlying list of list.
+func Dedup(ctx *Context, list *List) *List {
+	return WrapUnderlyingList(list.underlyingList.dedup(ctx))
+}
+
 func (l *List) Prop(ctx *Context, name string) Value {
 	switch name {
 	case "append":
@@ -107,6 +164,10 @@ func (l *List) Prop(ctx *Context, name string) Value {
 		return WrapGoMethod(l.Sorted)
 	case "sort_by":
 		return WrapGoMethod(l.SortBy)
+	case "insert_sorted":
+		return WrapGoMethod(l.InsertSorted)
+	case "chunk":
+		return WrapGoMethod(l.Chunk)
 	case "len":
 		return Int(l.Len())
 	default:
@@ -163,7 +224,17 @@ func (l *List) SetSlice(ctx *Context, start, end int, seq Sequence) {
 
 func (l *List) insertElement(ctx *Context, v Value, i Int) {
 	l.underlyingList.insertElement(ctx, v, i)
+	l.informAboutInsertedElement(ctx, v, i)
+}
+
+func (l *List) insertSorted(ctx *Context, v Serializable, less func(a, b Serializable) bool) {
+	i := l.underlyingList.insertSorted(ctx, v, less)
+	l.informAboutInsertedElement(ctx, v, i)
+}
 
+// informAboutInsertedElement updates the element mutation callbacks and informs watchers & microtasks
+// about the insertion of v at index i.
+func (l *List) informAboutInsertedElement(ctx *Context, v Value, i Int) {
 	if l.elementMutationCallbacks != nil {
 		l.elementMutationCallbacks = slices.Insert(l.elementMutationCallbacks, int(i), FIRST_VALID_CALLBACK_HANDLE-1)
 		l.addElementMutationCallbackNoLock(ctx, int(i), v)
@@ -257,6 +328,33 @@ func (l *List) Pop(ctx *Context) Serializable {
 	return elem.(Serializable)
 }
 
+// Chunk returns a new list containing the elements of l split in consecutive lists of size elements,
+// the last chunk is smaller if the length of l is not a multiple of size. The underlying list of
+// each chunk has the same kind as the underlying list of l.
+func (l *List) Chunk(ctx *Context, size Int) *List {
+	if size <= 0 {
+		panic(ErrInvalidChunkSize)
+	}
+
+	length := l.Len()
+	chunks := make([]Serializable, 0, (length+int(size)-1)/int(size))
+
+	for start := 0; start < length; start += int(size) {
+		end := min(start+int(size), length)
+
+		switch chunk := l.underlyingList.slice(start, end).(type) {
+		case *List:
+			chunks = append(chunks, chunk)
+		case underlyingList:
+			chunks = append(chunks, WrapUnderlyingList(chunk))
+		default:
+			panic(ErrUnreachable)
+		}
+	}
+
+	return NewWrappedValueListFrom(chunks)
+}
+
 func (l *List) RemoveAll(ctx *Context, filter Pattern) {
 	removedPositions := l.underlyingList.removeAll(ctx, filter)
 
@@ -52,4 +52,114 @@ func TestList(t *testing.T) {
 			list.RemoveAll(ctx, NewExactValuePattern(Int(2)))
 		})
 	})
+
+	t.Run("Reverse", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		ints := NewWrappedIntListFrom([]Int{1, 2, 3})
+		reversed := Reverse(ctx, ints)
+
+		assert.IsType(t, (*IntList)(nil), reversed.underlyingList)
+		assert.Equal(t, []Serializable{Int(3), Int(2), Int(1)}, reversed.GetOrBuildElements(ctx))
+
+		//the original list should not be modified.
+		assert.Equal(t, []Serializable{Int(1), Int(2), Int(3)}, ints.GetOrBuildElements(ctx))
+	})
+
+	t.Run("Dedup", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		ints := NewWrappedIntListFrom([]Int{2, 1, 2, 3, 1})
+		dedupedInts := Dedup(ctx, ints)
+
+		assert.IsType(t, (*IntList)(nil), dedupedInts.underlyingList)
+		assert.Equal(t, []Serializable{Int(2), Int(1), Int(3)}, dedupedInts.GetOrBuildElements(ctx))
+
+		//the original list should not be modified.
+		assert.Equal(t, []Serializable{Int(2), Int(1), Int(2), Int(3), Int(1)}, ints.GetOrBuildElements(ctx))
+
+		strings := NewWrappedStringListFrom([]StringLike{String("b"), String("a"), String("b")})
+		dedupedStrings := Dedup(ctx, strings)
+
+		assert.IsType(t, (*StringList)(nil), dedupedStrings.underlyingList)
+		assert.Equal(t, []Serializable{String("b"), String("a")}, dedupedStrings.GetOrBuildElements(ctx))
+
+		values := NewWrappedValueList(
+			NewObjectFromMapNoInit(ValMap{"a": Int(1)}),
+			String("a"),
+			NewObjectFromMapNoInit(ValMap{"a": Int(1)}),
+			Int(1),
+		)
+		dedupedValues := Dedup(ctx, values)
+
+		assert.IsType(t, (*ValueList)(nil), dedupedValues.underlyingList)
+		assert.Equal(t, []Serializable{values.At(ctx, 0).(Serializable), String("a"), Int(1)}, dedupedValues.GetOrBuildElements(ctx))
+	})
+
+	t.Run("chunk", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		ints := NewWrappedIntListFrom([]Int{1, 2, 3, 4, 5, 6, 7})
+		chunks := ints.Chunk(ctx, 3)
+
+		if !assert.Equal(t, 3, chunks.Len()) {
+			return
+		}
+
+		expectedChunks := [][]Serializable{{Int(1), Int(2), Int(3)}, {Int(4), Int(5), Int(6)}, {Int(7)}}
+		for i, expectedElements := range expectedChunks {
+			chunk := chunks.At(ctx, i).(*List)
+			assert.IsType(t, (*IntList)(nil), chunk.underlyingList)
+			assert.Equal(t, expectedElements, chunk.GetOrBuildElements(ctx))
+		}
+
+		//the original list should not be modified.
+		assert.Equal(t, []Serializable{Int(1), Int(2), Int(3), Int(4), Int(5), Int(6), Int(7)}, ints.GetOrBuildElements(ctx))
+
+		assert.PanicsWithError(t, ErrInvalidChunkSize.Error(), func() {
+			ints.Chunk(ctx, 0)
+		})
+	})
+
+	t.Run("insert_sorted", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		ints := NewWrappedIntListFrom([]Int{})
+		for _, i := range []Int{3, 1, 4, 1, 5} {
+			ints.InsertSorted(ctx, i, "asc")
+		}
+		assert.Equal(t, []Serializable{Int(1), Int(1), Int(3), Int(4), Int(5)}, ints.GetOrBuildElements(ctx))
+
+		ints = NewWrappedIntListFrom([]Int{})
+		for _, i := range []Int{3, 1, 4} {
+			ints.InsertSorted(ctx, i, "desc")
+		}
+		assert.Equal(t, []Serializable{Int(4), Int(3), Int(1)}, ints.GetOrBuildElements(ctx))
+
+		strings := NewWrappedStringListFrom([]StringLike{})
+		for _, s := range []String{"b", "c", "a"} {
+			strings.InsertSorted(ctx, s, "lex")
+		}
+		assert.Equal(t, []Serializable{String("a"), String("b"), String("c")}, strings.GetOrBuildElements(ctx))
+
+		assert.Panics(t, func() {
+			strings.InsertSorted(ctx, String("d"), "asc")
+		})
+
+		//elements of a different type
+		floats := NewWrappedFloatListFrom([]Float{1.0, 2.0})
+		assert.PanicsWithError(t, "insert_sorted: a(n) core.Int cannot be inserted in a list containing a(n) core.Float", func() {
+			floats.InsertSorted(ctx, Int(1), "asc")
+		})
+		assert.Equal(t, 2, floats.Len())
+
+		values := NewWrappedValueList(Int(1), String("a"))
+		assert.PanicsWithError(t, "insert_sorted: a(n) core.Int cannot be inserted in a list containing a(n) core.String", func() {
+			values.InsertSorted(ctx, Int(2), "asc")
+		})
+	})
 }
@@ -604,16 +604,16 @@ func (it *IntRangeIterator) Next(ctx *Context) bool {
 		return false
 	}
 
-	it.next += 1
+	it.next += it.range_.step
 	return true
 }
 
 func (it *IntRangeIterator) Key(ctx *Context) Value {
-	return Int(it.next - 1 - it.range_.start)
+	return Int((it.next - it.range_.step - it.range_.start) / it.range_.step)
 }
 
 func (it *IntRangeIterator) Value(*Context) Value {
-	return Int(it.next - 1)
+	return Int(it.next - it.range_.step)
 }
 
 func (r IntRange) Iterator(ctx *Context, config IteratorConfiguration) Iterator {
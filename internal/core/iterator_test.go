@@ -146,6 +146,29 @@ func TestIntegerQuantityRangeIteration(t *testing.T) {
 	})
 }
 
+func TestSteppedIntRangeIteration(t *testing.T) {
+	ctx := NewContext(ContextConfig{})
+	NewGlobalState(ctx)
+
+	intRange := NewSteppedIntRange(0, 10, 2)
+	it := intRange.Iterator(ctx, IteratorConfiguration{})
+
+	var keys, values []Value
+
+	for it.Next(ctx) {
+		keys = append(keys, it.Key(ctx))
+		values = append(values, it.Value(ctx))
+	}
+
+	assert.Equal(t, []Value{Int(0), Int(1), Int(2), Int(3), Int(4), Int(5)}, keys)
+	assert.Equal(t, []Value{Int(0), Int(2), Int(4), Int(6), Int(8), Int(10)}, values)
+
+	assert.Equal(t, 6, intRange.Len())
+	assert.Equal(t, Int(4), intRange.At(ctx, 2))
+	assert.True(t, intRange.Includes(ctx, 6))
+	assert.False(t, intRange.Includes(ctx, 7))
+}
+
 func TestByteSliceIteration(t *testing.T) {
 
 	t.Run("single byte", func(t *testing.T) {
@@ -444,7 +444,7 @@ func convertJsonSchemaToPattern(schema *jsonschema.Schema, baseSchema *jsonschem
 	if allowString {
 		var pattern Pattern
 
-		var lengthRange = IntRange{}
+		var lengthRange = IntRange{step: 1}
 		var hasLengthRange bool
 
 		if schema.MinLength >= 0 {
@@ -564,6 +564,29 @@ func Sleep(ctx *Context, d Duration) {
 	ctx.Sleep(time.Duration(d))
 }
 
+// EstimateLThreadPermissions estimates the permissions granted to the lthread created by a spawn expression.
+// If the spawn expression has an 'allow' section the permissions are estimated from the section, otherwise
+// the lthread inherits the permissions of ctx minus the implicitly removed routine permissions. If the 'allow'
+// section is not an object literal the permissions cannot be estimated and (nil, nil) is returned.
+func EstimateLThreadPermissions(spawnNode *parse.SpawnExpression, ctx *Context) ([]Permission, error) {
+	if objLit, ok := spawnNode.Meta.(*parse.ObjectLiteral); ok {
+		for _, sectionProp := range objLit.Properties {
+			if sectionProp.HasImplicitKey() || sectionProp.Name() != symbolic.LTHREAD_META_ALLOW_SECTION {
+				continue
+			}
+
+			permListingNode, ok := sectionProp.Value.(*parse.ObjectLiteral)
+			if !ok {
+				//the permission listing is only known at runtime.
+				return nil, nil
+			}
+			return estimatePermissionsFromListingNode(permListingNode)
+		}
+	}
+
+	return RemovePerms(ctx.GetGrantedPermissions(), IMPLICITLY_REMOVED_ROUTINE_PERMS), nil
+}
+
 func readLThreadMeta(meta map[string]Value, ctx *Context) (group *LThreadGroup, globalsDesc Value, permListing *Object, err error) {
 	if val, ok := meta[symbolic.LTHREAD_META_GROUP_SECTION]; ok {
 		if rtGroup, ok := val.(*LThreadGroup); ok {
@@ -207,3 +207,60 @@ func TestSpawnLThread(t *testing.T) {
 	})
 
 }
+
+func TestEstimateLThreadPermissions(t *testing.T) {
+
+	t.Run("allow section", func(t *testing.T) {
+		ctx := NewContext(ContextConfig{})
+		defer ctx.CancelGracefully()
+
+		chunk := parse.MustParseChunk(`go {allow: {read: %/...}} do {}`)
+		spawnExpr := parse.FindNode(chunk, (*parse.SpawnExpression)(nil), nil)
+
+		perms, err := EstimateLThreadPermissions(spawnExpr, ctx)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.Equal(t, []Permission{
+			FilesystemPermission{Kind_: permkind.Read, Entity: PathPattern("/...")},
+		}, perms)
+	})
+
+	t.Run("no allow section", func(t *testing.T) {
+		ctx := NewContext(ContextConfig{
+			Permissions: []Permission{
+				GlobalVarPermission{Kind_: permkind.Read, Name: "*"},
+				LThreadPermission{permkind.Create},
+			},
+		})
+		defer ctx.CancelGracefully()
+
+		chunk := parse.MustParseChunk(`go {globals: {}} do {}`)
+		spawnExpr := parse.FindNode(chunk, (*parse.SpawnExpression)(nil), nil)
+
+		perms, err := EstimateLThreadPermissions(spawnExpr, ctx)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		//the permission to create lthreads is implicitly removed.
+		assert.Equal(t, []Permission{
+			GlobalVarPermission{Kind_: permkind.Read, Name: "*"},
+		}, perms)
+	})
+
+	t.Run("allow section that is not an object literal", func(t *testing.T) {
+		ctx := NewContext(ContextConfig{})
+		defer ctx.CancelGracefully()
+
+		chunk := parse.MustParseChunk(`go {allow: perms} do {}`)
+		spawnExpr := parse.FindNode(chunk, (*parse.SpawnExpression)(nil), nil)
+
+		perms, err := EstimateLThreadPermissions(spawnExpr, ctx)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Nil(t, perms)
+	})
+}
@@ -19,7 +19,7 @@ var (
 	}
 
 	_ = []MigrationCapable{
-		(*Object)(nil), (*Record)(nil), //(*List)(nil),
+		(*Object)(nil), (*Record)(nil), (*List)(nil), (*Tuple)(nil),
 	}
 )
 
@@ -733,6 +733,9 @@ func migrateListOrTuple(
 	//note: nextRecordKeys should not be use to check for the presence of a next record because it could be nil
 	var nextTuple bool
 
+	//indexes of the elements to remove
+	var removedIndexes []int
+
 	getIndex := func(elementPath []string) (int, error) {
 		index, err := strconv.Atoi(elementPath[len(elementPath)-1])
 
@@ -808,15 +811,9 @@ func migrateListOrTuple(
 					}
 				}
 
-				if list, ok := o.(*List); ok {
-					list.removePosition(ctx, Int(index))
-				} else {
-					if !nextTuple {
-						nextTuple = true
-						nextTupleElements = slices.Clone(o.(*Tuple).elements)
-					}
-					nextTupleElements = slices.Delete(nextTupleElements, index, index+1)
-				}
+				//the removal is performed after all deletions have been handled because
+				//removing an element changes the indexes of the following elements.
+				removedIndexes = append(removedIndexes, index)
 			}
 		case pathPatternDepth > 1+depth: //deletion inside element value
 			elementPathPattern := pathPatternSegments[:depth+1]
@@ -853,6 +850,22 @@ func migrateListOrTuple(
 		}
 	}
 
+	//remove the deleted elements, starting from the last one.
+	slices.Sort(removedIndexes)
+	for i := len(removedIndexes) - 1; i >= 0; i-- {
+		index := removedIndexes[i]
+
+		if list, ok := o.(*List); ok {
+			list.removePosition(ctx, Int(index))
+		} else {
+			if !nextTuple {
+				nextTuple = true
+				nextTupleElements = slices.Clone(o.(*Tuple).elements)
+			}
+			nextTupleElements = slices.Delete(nextTupleElements, index, index+1)
+		}
+	}
+
 	handle := func(pathPattern PathPattern, handler *MigrationOpHandler, kind MigrationOpKind) (outerFunctionResult Value, outerFunctionError error) {
 		pathPatternSegments := pathutils.GetPathSegments(string(pathPattern))
 		pathPatternDepth := len(pathPatternSegments)
@@ -1312,6 +1312,51 @@ func TestListMigrate(t *testing.T) {
 		assert.Equal(t, []Serializable{}, list.GetOrBuildElements(ctx))
 	})
 
+	t.Run("delete several elements", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		list := NewWrappedValueList(Int(0), Int(1), Int(2))
+		val, err := list.Migrate(ctx, "/", &FreeEntityMigrationArgs{
+			NextPattern: nil,
+			MigrationHandlers: MigrationOpHandlers{
+				Deletions: map[PathPattern]*MigrationOpHandler{
+					"/0": nil,
+					"/1": nil,
+				},
+			},
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Same(t, list, val)
+		assert.Equal(t, []Serializable{Int(2)}, list.GetOrBuildElements(ctx))
+	})
+
+	t.Run("delete all elements", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		list := NewWrappedValueList(Int(0), Int(1))
+		val, err := list.Migrate(ctx, "/", &FreeEntityMigrationArgs{
+			NextPattern: nil,
+			MigrationHandlers: MigrationOpHandlers{
+				Deletions: map[PathPattern]*MigrationOpHandler{
+					"/*": nil,
+				},
+			},
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+		if !assert.IsType(t, (*List)(nil), val) {
+			return
+		}
+		assert.Equal(t, []Serializable{}, val.(*List).GetOrBuildElements(ctx))
+	})
+
 	t.Run("delete inexisting element (index >= len)", func(t *testing.T) {
 		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
 		defer ctx.CancelGracefully()
@@ -7,8 +7,9 @@ import (
 )
 
 var (
-	ErrUnknownStartIntRange   = errors.New("integer range has unknown start")
-	ErrUnknownStartFloatRange = errors.New("float range has unknown start")
+	ErrUnknownStartIntRange    = errors.New("integer range has unknown start")
+	ErrUnknownStartFloatRange  = errors.New("float range has unknown start")
+	ErrNonPositiveIntRangeStep = errors.New("the step of an integer range should be greater than zero")
 
 	_ = []Integral{Int(0), Byte(0), ByteCount(0), RuneCount(0), LineCount(0)}
 )
@@ -167,7 +168,7 @@ type IntRange struct {
 	unknownStart bool //if true .Start depends on the context (not *Context)
 	start        int64
 	end          int64 //inclusive
-	step         int64 //only 1 supported for now
+	step         int64 //always > 0, some operations only support a step of 1
 }
 
 func NewIntRange(start, inclusiveEnd int64) IntRange {
@@ -181,6 +182,22 @@ func NewIntRange(start, inclusiveEnd int64) IntRange {
 	}
 }
 
+// NewSteppedIntRange creates an IntRange that only includes the integers start, start+step, start+2*step, ...
+// that are <= inclusiveEnd, step should be > 0.
+func NewSteppedIntRange(start, inclusiveEnd, step int64) IntRange {
+	if inclusiveEnd < start {
+		panic(fmt.Errorf("failed to create int range, end < start"))
+	}
+	if step <= 0 {
+		panic(ErrNonPositiveIntRangeStep)
+	}
+	return IntRange{
+		start: start,
+		end:   inclusiveEnd,
+		step:  step,
+	}
+}
+
 func NewUnknownStartIntRange(end int64) IntRange {
 	return IntRange{
 		unknownStart: true,
@@ -194,14 +211,17 @@ func (r IntRange) Includes(ctx *Context, i Int) bool {
 		panic(ErrUnknownStartIntRange)
 	}
 
-	return r.start <= int64(i) && int64(i) <= r.InclusiveEnd()
+	if r.start > int64(i) || int64(i) > r.InclusiveEnd() {
+		return false
+	}
+	return r.step == 1 || (int64(i)-r.start)%r.step == 0
 }
 
 func (r IntRange) At(ctx *Context, i int) Value {
 	if i >= r.Len() {
 		panic(ErrIndexOutOfRange)
 	}
-	return Int(i + int(r.start))
+	return Int(r.start + int64(i)*r.step)
 }
 
 // Len returns the number of integers in the range if the start (lower bound) is known.
@@ -234,6 +254,12 @@ func (r IntRange) len(min int64) int {
 	if r.unknownStart {
 		start = min
 	}
+	if r.step > 1 {
+		if r.end < start {
+			return 0
+		}
+		return int((r.end-start)/r.step + 1)
+	}
 	length := r.end - start + 1
 	return int(length)
 }
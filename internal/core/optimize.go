@@ -4,10 +4,64 @@ import (
 	"fmt"
 	"io"
 	"reflect"
+
+	"github.com/inoxlang/inox/internal/parse"
 )
 
 func optimizeBytecode(b *Bytecode, tracer io.Writer) {
 	deduplicateConstants(b, tracer)
+	fuseLocalIncrements(b)
+}
+
+// DeduplicateConstants returns a copy of the bytecode in which equal constants are merged, the constant indexes
+// in the instructions of the main function and of the compiled functions are remapped accordingly.
+// Immutable serializable constants (integers, strings, records, ...) are compared with Equal, other constants
+// (functions, nested bytecodes, ...) are compared by identity. b is not modified.
+func (b *Bytecode) DeduplicateConstants() *Bytecode {
+	newBytecode := &Bytecode{
+		module:    b.module,
+		constants: make([]Value, len(b.constants)),
+	}
+
+	//compiled functions are copied because deduplicateConstants updates their instructions.
+	copiedFunctions := map[*InoxFunction]*InoxFunction{}
+
+	for i, constant := range b.constants {
+		if fn, ok := constant.(*InoxFunction); ok && fn.compiledFunction != nil {
+			fnCopy, ok := copiedFunctions[fn]
+			if !ok {
+				compiledFn := *fn.compiledFunction
+				compiledFn.Bytecode = newBytecode
+
+				//all fields except the lock are copied.
+				fnCopy = &InoxFunction{
+					Node:                   fn.Node,
+					Chunk:                  fn.Chunk,
+					originState:            fn.originState,
+					treeWalkCapturedLocals: fn.treeWalkCapturedLocals,
+					capturedGlobals:        fn.capturedGlobals,
+					compiledFunction:       &compiledFn,
+					capturedLocals:         fn.capturedLocals,
+					symbolicValue:          fn.symbolicValue,
+					staticData:             fn.staticData,
+					watchers:               fn.watchers,
+					mutationCallbacks:      fn.mutationCallbacks,
+					watchingDepth:          fn.watchingDepth,
+				}
+				fnCopy.shared.Store(fn.shared.Load())
+				copiedFunctions[fn] = fnCopy
+			}
+			constant = fnCopy
+		}
+		newBytecode.constants[i] = constant
+	}
+
+	main := *b.main
+	main.Bytecode = newBytecode
+	newBytecode.main = &main
+
+	deduplicateConstants(newBytecode, nil)
+	return newBytecode
 }
 
 func deduplicateConstants(b *Bytecode, tracer io.Writer) {
@@ -29,21 +83,23 @@ func deduplicateConstants(b *Bytecode, tracer io.Writer) {
 		newConstantIndex := len(newConstants)
 		constantsMapping[i] = newConstantIndex
 		newConstants = append(newConstants, c1)
-		v := reflect.ValueOf(c1)
 
-		//we ignore values that are not integers, floats, strings nor booleans.
+		//values that are mutable or not serializable are compared by identity.
 		//TODO: support checked strings
-		switch v.Kind() {
-		case reflect.Bool, reflect.String:
-		default:
-			if !v.CanInt() && !v.CanFloat() {
-				continue
+		if serializable, ok := c1.(Serializable); !ok || serializable.IsMutable() {
+			if reflect.ValueOf(c1).Kind() == reflect.Pointer {
+				for j := i + 1; j < len(b.constants); j++ {
+					if c1 == b.constants[j] {
+						constantsMapping[j] = newConstantIndex
+					}
+				}
 			}
+			continue
 		}
 
 		for j := i + 1; j < len(b.constants); j++ {
 			c2 := b.constants[j]
-			if c1.Equal(nil, c2, map[uintptr]uintptr{}, 0) {
+			if c1.Equal(ctx, c2, map[uintptr]uintptr{}, 0) {
 				jsonReprConfig := JSONSerializationConfig{ReprConfig: ALL_VISIBLE_REPR_CONFIG}
 
 				if tracer != nil {
@@ -75,8 +131,9 @@ func deduplicateConstants(b *Bytecode, tracer io.Writer) {
 		)
 	}
 
-	//we update compiled functions' instructions
-	for _, c := range b.constants {
+	//we update compiled functions' instructions, a function present at several indexes is only present once in newConstants
+	//so its instructions are not remapped twice.
+	for _, c := range newConstants {
 		if fn, ok := c.(*InoxFunction); ok && fn.compiledFunction != nil {
 			newInstructions, err := updateConstantReferences(fn.compiledFunction)
 			if err != nil {
@@ -96,3 +153,67 @@ func deduplicateConstants(b *Bytecode, tracer io.Writer) {
 	b.main.Instructions = newInstructions
 	b.constants = newConstants
 }
+
+// fuseLocalIncrements replaces the instruction sequences emitted for local increments such as `i += 1`
+// (GET_LOCAL n, PUSH_CONST <1>, INT_BIN +, SET_LOCAL n) by a single INC_LOCAL instruction. The INC_LOCAL instruction
+// is followed by NO_OP instructions that are skipped by the VM, this way the positions of the other instructions,
+// jump targets and source maps are preserved.
+func fuseLocalIncrements(b *Bytecode) {
+	for _, c := range b.constants {
+		if fn, ok := c.(*InoxFunction); ok && fn.compiledFunction != nil {
+			fuseLocalIncrementsInInstructions(fn.compiledFunction.Instructions, b.constants)
+		}
+	}
+
+	fuseLocalIncrementsInInstructions(b.main.Instructions, b.constants)
+}
+
+func fuseLocalIncrementsInInstructions(instructions []byte, constants []Value) {
+	var positions []int
+	jumpTargets := map[int]bool{}
+
+	for i := 0; i < len(instructions); {
+		op := instructions[i]
+		positions = append(positions, i)
+
+		switch op {
+		case OpJumpIfFalse, OpAndJump, OpOrJump, OpJump, OpPopJumpIfTestDisabled:
+			jumpTargets[int(instructions[i+2])|int(instructions[i+1])<<8] = true
+		}
+
+		_, read := ReadOperands(OpcodeOperands[op], instructions[i+1:])
+		i += 1 + read
+	}
+
+	for k := 0; k+3 < len(positions); k++ {
+		getLocal, pushConst, intBin, setLocal := positions[k], positions[k+1], positions[k+2], positions[k+3]
+
+		if instructions[getLocal] != OpGetLocal || instructions[pushConst] != OpPushConstant ||
+			instructions[intBin] != OpIntBin || instructions[setLocal] != OpSetLocal {
+			continue
+		}
+
+		localIndex := instructions[getLocal+1]
+		constantIndex := int(instructions[pushConst+2]) | int(instructions[pushConst+1])<<8
+
+		if instructions[setLocal+1] != localIndex ||
+			parse.BinaryOperator(instructions[intBin+1]) != parse.Add ||
+			constants[constantIndex] != Int(1) {
+			continue
+		}
+
+		//the sequence should only be entered at its start.
+		if jumpTargets[pushConst] || jumpTargets[intBin] || jumpTargets[setLocal] {
+			continue
+		}
+
+		end := setLocal + 1 + len(OpcodeOperands[OpSetLocal])
+		incLocal := MakeInstruction(OpIncLocal, int(localIndex), end-getLocal-3)
+		copy(instructions[getLocal:], incLocal)
+
+		for i := getLocal + len(incLocal); i < end; i++ {
+			instructions[i] = OpNoOp
+		}
+		k += 3
+	}
+}
@@ -1656,11 +1656,13 @@ func parseIntRangeJSONRepresentation(ctx *Context, it *jsoniter.Iterator, try bo
 
 		hasEnd bool
 		end    int64 = math.MaxInt64
+
+		step int64 = 1
 	)
 
 	it.ReadObjectCB(func(it *jsoniter.Iterator, s string) bool {
 		switch s {
-		case SERIALIZED_INT_RANGE_START_KEY, SERIALIZED_INT_RANGE_START_END_KEY:
+		case SERIALIZED_INT_RANGE_START_KEY, SERIALIZED_INT_RANGE_START_END_KEY, SERIALIZED_INT_RANGE_STEP_KEY:
 			var n int64
 			var err error
 
@@ -1685,6 +1687,8 @@ func parseIntRangeJSONRepresentation(ctx *Context, it *jsoniter.Iterator, try bo
 			case SERIALIZED_INT_RANGE_START_END_KEY:
 				hasEnd = true
 				end = n
+			case SERIALIZED_INT_RANGE_STEP_KEY:
+				step = n
 			}
 			return true
 		default:
@@ -1707,12 +1711,22 @@ func parseIntRangeJSONRepresentation(ctx *Context, it *jsoniter.Iterator, try bo
 		return
 	}
 
+	if step <= 0 {
+		finalErr = errors.New("invalid integer range: step should be greater than zero")
+		return
+	}
+
 	if hasStart {
 		if start > end {
 			finalErr = errors.New("invalid integer range: start > end")
 			return
 		}
-		return NewIntRange(start, end), nil
+		return NewSteppedIntRange(start, end, step), nil
+	}
+
+	if step != 1 {
+		finalErr = errors.New("invalid integer range: a range with an unknown start cannot have a step")
+		return
 	}
 
 	return NewUnknownStartIntRange(end), nil
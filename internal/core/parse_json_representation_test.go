@@ -974,6 +974,29 @@ func TestParseJSONRepresentation(t *testing.T) {
 			}
 		})
 
+		t.Run("step", func(t *testing.T) {
+			intRange := NewSteppedIntRange(0, 10, 3)
+			serialized := MustGetJSONRepresentationWithConfig(intRange, ctx, config)
+
+			v, err := ParseJSONRepresentation(ctx, `{"int-range__value":`+serialized+`}`, nil)
+			if assert.NoError(t, err) {
+				assert.Equal(t, intRange, v)
+			}
+
+			v, err = ParseJSONRepresentation(ctx, serialized, INT_RANGE_PATTERN)
+			if assert.NoError(t, err) {
+				assert.Equal(t, intRange, v)
+			}
+		})
+
+		t.Run("invalid step", func(t *testing.T) {
+			_, err := ParseJSONRepresentation(ctx, `{"start":0,"end":10,"step":0}`, INT_RANGE_PATTERN)
+			assert.Error(t, err)
+
+			_, err = ParseJSONRepresentation(ctx, `{"end":10,"step":2}`, INT_RANGE_PATTERN)
+			assert.Error(t, err)
+		})
+
 	})
 
 	t.Run("float ranges", func(t *testing.T) {
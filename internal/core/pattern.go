@@ -188,6 +188,9 @@ type TypePattern struct {
 	CallImpl         func(ctx *Context, pattern *TypePattern, values []Serializable) (Pattern, error)
 	SymbolicCallImpl func(ctx *symbolic.Context, values []symbolic.Value) (symbolic.Pattern, error)
 
+	//optional, used to check the number of arguments of pattern calls before calling SymbolicCallImpl.
+	SymbolicCallArity *symbolic.CallArity
+
 	stringPattern         func() (StringPattern, bool)
 	symbolicStringPattern func() (symbolic.StringPattern, bool)
 }
@@ -607,6 +610,38 @@ func (patt *ObjectPattern) CompleteEntry(name string) (ObjectPatternEntry, bool)
 	return ObjectPatternEntriesHelper(patt.entries).CompleteEntry(name)
 }
 
+// addSpreadEntries adds the entries of $spread (object or record pattern) that are not already present,
+// dependencies are ignored. The pattern becomes inexact if $spread is inexact. The caller should call .init() afterwards.
+func (patt *ObjectPattern) addSpreadEntries(spread Pattern) {
+	add := func(entry ObjectPatternEntry) {
+		//priority to property pattern defined earlier.
+		if patt.HasRequiredOrOptionalEntry(entry.Name) {
+			//already present.
+			return
+		}
+		patt.entries = append(patt.entries, entry)
+	}
+
+	switch spread := spread.(type) {
+	case *ObjectPattern:
+		for _, entry := range spread.entries {
+			add(ObjectPatternEntry{Name: entry.Name, Pattern: entry.Pattern, IsOptional: entry.IsOptional})
+		}
+		if spread.inexact {
+			patt.inexact = true
+		}
+	case *RecordPattern:
+		for _, entry := range spread.entries {
+			add(ObjectPatternEntry{Name: entry.Name, Pattern: entry.Pattern, IsOptional: entry.IsOptional})
+		}
+		if spread.inexact {
+			patt.inexact = true
+		}
+	default:
+		panic(ErrUnreachable)
+	}
+}
+
 // A RecordPattern represents a pattern matching Inox records (e.g. #{a: 1}), RecordPattern implements Value.
 type RecordPattern struct {
 	NotCallablePatternMixin
@@ -705,6 +740,39 @@ func (patt *RecordPattern) HasRequiredOrOptionalEntry(name string) bool {
 	return ok
 }
 
+// addSpreadEntries adds the entries of $spread (record or object pattern) that are not already present.
+// The entries of object patterns are expected to only match immutable values (this is checked during
+// the symbolic check). The pattern becomes inexact if $spread is inexact. The caller should call .init() afterwards.
+func (patt *RecordPattern) addSpreadEntries(spread Pattern) {
+	add := func(entry RecordPatternEntry) {
+		//priority to property pattern defined earlier.
+		if patt.HasRequiredOrOptionalEntry(entry.Name) {
+			//already present.
+			return
+		}
+		patt.entries = append(patt.entries, entry)
+	}
+
+	switch spread := spread.(type) {
+	case *RecordPattern:
+		for _, entry := range spread.entries {
+			add(RecordPatternEntry{Name: entry.Name, Pattern: entry.Pattern, IsOptional: entry.IsOptional})
+		}
+		if spread.inexact {
+			patt.inexact = true
+		}
+	case *ObjectPattern:
+		for _, entry := range spread.entries {
+			add(RecordPatternEntry{Name: entry.Name, Pattern: entry.Pattern, IsOptional: entry.IsOptional})
+		}
+		if spread.inexact {
+			patt.inexact = true
+		}
+	default:
+		panic(ErrUnreachable)
+	}
+}
+
 func (patt *RecordPattern) Entry(name string) (pattern Pattern, optional bool, yes bool) {
 	entry, ok := patt.CompleteEntry(name)
 	if !ok {
@@ -151,7 +151,7 @@ func GetNodeColorizations(chunk *parse.Chunk, lightMode bool) []ColorizationInfo
 			parse.FN_KEYWORD, parse.CONST_KEYWORD, parse.VAR_KEYWORD, parse.ASSIGN_KEYWORD, parse.CONCAT_KEYWORD,
 			parse.SENDVAL_KEYWORD, parse.SYNCHRONIZED_KEYWORD, parse.EXTEND_KEYWORD, parse.PATTERN_KEYWORD,
 			parse.PNAMESPACE_KEYWORD, parse.STRUCT_KEYWORD, parse.NEW_KEYWORD, parse.SELF_KEYWORD, parse.URLOF_KEYWORD,
-			parse.KEYOF_KEYWORD,
+			parse.KEYOF_KEYWORD, parse.AS_KEYWORD, parse.STEP_KEYWORD,
 			parse.NOT_IN_KEYWORD, parse.NOT_MATCH_KEYWORD:
 			colorizations = append(colorizations, ColorizationInfo{
 				Span:          token.Span,
@@ -580,14 +580,10 @@ func (dict *Dictionary) PrettyPrint(w *bufio.Writer, config *PrettyPrintConfig,
 
 	utils.Must(w.Write(utils.StringAsBytes(":{")))
 
-	var keys []string
-	for k := range dict.entries {
-		keys = append(keys, k)
-	}
-
-	sort.Strings(keys)
+	i := 0
+	entryCount := len(dict.entries)
 
-	for i, k := range keys {
+	dict.ForEachEntrySorted(nil, func(k string, _, v Serializable) error {
 		if !config.Compact {
 			utils.Must(w.Write(LF_CR))
 			utils.Must(w.Write(indent))
@@ -608,21 +604,20 @@ func (dict *Dictionary) PrettyPrint(w *bufio.Writer, config *PrettyPrintConfig,
 		utils.Must(w.Write(COLON_SPACE))
 
 		//value
-		v := dict.entries[k]
-
 		v.PrettyPrint(w, config, depth+1, indentCount)
 
 		//comma & indent
-		isLastEntry := i == len(keys)-1
+		isLastEntry := i == entryCount-1
 
 		if !isLastEntry {
 			utils.Must(w.Write([]byte{',', ' '}))
 
 		}
+		i++
+		return nil
+	})
 
-	}
-
-	if !config.Compact && len(keys) > 0 {
+	if !config.Compact && entryCount > 0 {
 		utils.Must(w.Write(LF_CR))
 	}
 	utils.Must(w.Write(bytes.Repeat(config.Indent, depth)))
@@ -1382,6 +1377,10 @@ func (r IntRange) write(w io.Writer) (int, error) {
 	}
 	b = append(b, '.', '.')
 	b = append(b, strconv.FormatInt(r.end, 10)...)
+	if r.step > 1 {
+		b = append(b, " step "...)
+		b = append(b, strconv.FormatInt(r.step, 10)...)
+	}
 
 	return w.Write(b)
 }
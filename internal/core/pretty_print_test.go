@@ -1088,6 +1088,20 @@ func TestIntRangePrettyPrint(t *testing.T) {
 		state := NewTreeWalkState(NewContext(ContextConfig{}))
 		assert.Equal(t, intRange, utils.Must(TreeWalkEval(node, state)))
 	})
+
+	t.Run("step", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		intRange := IntRange{start: 0, end: 100, step: 2}
+
+		expectedRepr := "0..100 step 2"
+		assert.Equal(t, expectedRepr, Stringify(intRange, ctx))
+
+		node := assertParseExpression(t, expectedRepr)
+		state := NewTreeWalkState(NewContext(ContextConfig{}))
+		assert.Equal(t, intRange, utils.Must(TreeWalkEval(node, state)))
+	})
 }
 
 func TestFloatRangePrettyPrint(t *testing.T) {
@@ -182,6 +182,10 @@ func (r IntRange) Random(ctx *Context) Value {
 	}
 	start := r.start
 	end := r.end
+	if r.step > 1 {
+		index := DefaultRandSource.RandInt64Range(0, int64(r.Len()-1))
+		return Int(start + index*r.step)
+	}
 	return Int(DefaultRandSource.RandInt64Range(int64(start), int64(end)))
 }
 
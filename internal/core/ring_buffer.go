@@ -14,6 +14,7 @@ var (
 	ErrEmptyRingBuffer              = errors.New("ring buffer is empty")
 	ErrFullRingBuffer               = errors.New("ring buffer is full")
 	ErrRingBufferTooMuchDataToWrite = errors.New("too much data to write to ring buffer")
+	ErrDelimNotFound                = errors.New("delimiter not found in the readable data of the ring buffer")
 )
 
 func init() {
@@ -104,6 +105,29 @@ func (r *RingBuffer) ReadByte() (b byte, err error) {
 	return b, err
 }
 
+// ReadUntil reads until the first occurrence of delim in the readable data, the returned slice includes the delimiter.
+// If delim is not present nothing is consumed and ErrDelimNotFound is returned (ErrEmptyRingBuffer if the buffer is empty).
+func (r *RingBuffer) ReadUntil(delim byte) ([]byte, error) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.writeCursor == r.readCursor && !r.full {
+		return nil, ErrEmptyRingBuffer
+	}
+
+	readableCount := r.readableCount()
+
+	for i := 0; i < readableCount; i++ {
+		if r.data[(r.readCursor+i)%r.size] == delim {
+			p := make([]byte, i+1)
+			n, err := r.read(p)
+			return p[:n], err
+		}
+	}
+
+	return nil, ErrDelimNotFound
+}
+
 func (r *RingBuffer) Write(p []byte) (n int, err error) {
 	if len(p) == 0 {
 		return 0, nil
@@ -216,24 +240,34 @@ func (r *RingBuffer) ReadableCount(ctx *Context) ByteCount {
 	r.lock.Lock()
 	defer r.lock.Unlock()
 
+	return ByteCount(r.readableCount())
+}
+
+func (r *RingBuffer) readableCount() int {
 	if r.writeCursor == r.readCursor {
 		if r.full {
-			return ByteCount(r.size)
+			return r.size
 		}
 		return 0
 	}
 
 	if r.writeCursor > r.readCursor {
-		return ByteCount(r.writeCursor - r.readCursor)
+		return r.writeCursor - r.readCursor
 	}
 
-	return ByteCount(r.size - r.readCursor + r.writeCursor)
+	return r.size - r.readCursor + r.writeCursor
 }
 
+// Capacity returns the size of the backing array.
 func (r *RingBuffer) Capacity() int {
 	return r.size
 }
 
+// Cap returns the maximum number of readable bytes the buffer can hold, it is equal to Capacity().
+func (r *RingBuffer) Cap() int {
+	return r.size
+}
+
 func (r *RingBuffer) Free() ByteCount {
 	r.lock.Lock()
 	defer r.lock.Unlock()
@@ -252,6 +286,7 @@ func (r *RingBuffer) Free() ByteCount {
 	return ByteCount(r.size - r.writeCursor + r.readCursor)
 }
 
+// Reset makes the buffer empty, the backing array is not reallocated so the buffer can be reused.
 func (r *RingBuffer) Reset() {
 	r.lock.Lock()
 	defer r.lock.Unlock()
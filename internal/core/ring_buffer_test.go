@@ -0,0 +1,133 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRingBuffer(t *testing.T) {
+
+	t.Run("Reset", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		buffer := NewRingBuffer(ctx, 4)
+
+		_, err := buffer.WriteString("abc")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		p := make([]byte, 2)
+		n, err := buffer.Read(p)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, "ab", string(p[:n]))
+
+		_, err = buffer.WriteString("de")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		buffer.Reset()
+
+		assert.True(t, buffer.IsEmpty())
+		assert.False(t, buffer.IsFull())
+		assert.Equal(t, ByteCount(0), buffer.ReadableCount(ctx))
+		assert.Equal(t, ByteCount(4), buffer.Free())
+		assert.Equal(t, 4, buffer.Capacity())
+		assert.Equal(t, 4, buffer.Cap())
+
+		//the whole capacity should be writable again.
+		n, err = buffer.WriteString("fghi")
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Equal(t, 4, n)
+		assert.True(t, buffer.IsFull())
+		assert.Equal(t, []byte("fghi"), buffer.ReadableBytesCopy())
+	})
+
+	t.Run("ReadUntil", func(t *testing.T) {
+		t.Run("delimiter in the middle of the readable data", func(t *testing.T) {
+			ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+			defer ctx.CancelGracefully()
+
+			buffer := NewRingBuffer(ctx, 8)
+
+			_, err := buffer.WriteString("ab\ncd")
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			line, err := buffer.ReadUntil('\n')
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "ab\n", string(line))
+			assert.Equal(t, []byte("cd"), buffer.ReadableBytesCopy())
+		})
+
+		t.Run("delimiter after the end of the backing array", func(t *testing.T) {
+			ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+			defer ctx.CancelGracefully()
+
+			buffer := NewRingBuffer(ctx, 4)
+
+			_, err := buffer.WriteString("abc")
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			p := make([]byte, 2)
+			_, err = buffer.Read(p)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			//the readable data (c, d, \n) wraps around the end of the backing array.
+			_, err = buffer.WriteString("d\n")
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			line, err := buffer.ReadUntil('\n')
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, "cd\n", string(line))
+			assert.True(t, buffer.IsEmpty())
+		})
+
+		t.Run("delimiter not present", func(t *testing.T) {
+			ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+			defer ctx.CancelGracefully()
+
+			buffer := NewRingBuffer(ctx, 8)
+
+			_, err := buffer.WriteString("abc")
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			line, err := buffer.ReadUntil('\n')
+			assert.ErrorIs(t, err, ErrDelimNotFound)
+			assert.Nil(t, line)
+
+			//nothing should have been consumed.
+			assert.Equal(t, []byte("abc"), buffer.ReadableBytesCopy())
+		})
+
+		t.Run("empty buffer", func(t *testing.T) {
+			ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+			defer ctx.CancelGracefully()
+
+			buffer := NewRingBuffer(ctx, 8)
+
+			_, err := buffer.ReadUntil('\n')
+			assert.ErrorIs(t, err, ErrEmptyRingBuffer)
+		})
+	})
+}
@@ -141,6 +141,83 @@ func (l *List) SortBy(ctx *Context, path ValuePath, orderIdent Identifier) {
 	}
 }
 
+// InsertSorted inserts v in the list, the list is expected to be sorted according to the given order.
+func (l *List) InsertSorted(ctx *Context, v Serializable, orderIdent Identifier) {
+	const ERR_PREFIX = "insert_sorted:"
+
+	order, ok := symbolic.OrderFromString(orderIdent.UnderlyingString())
+	if !ok {
+		panic(ErrInvalidOrderIdentifier)
+	}
+
+	var less func(a, b Serializable) bool
+	var hasSameType func(e Value) bool //less expects the elements of the list to have the same type as v
+
+	switch v.(type) {
+	case StringLike:
+		hasSameType = func(e Value) bool {
+			_, ok := e.(StringLike)
+			return ok
+		}
+		switch order {
+		case symbolic.LexicographicOrder:
+			less = func(a, b Serializable) bool {
+				return a.(StringLike).GetOrBuildString() < b.(StringLike).GetOrBuildString()
+			}
+		case symbolic.ReverseLexicographicOrder:
+			less = func(a, b Serializable) bool {
+				return a.(StringLike).GetOrBuildString() > b.(StringLike).GetOrBuildString()
+			}
+		default:
+			panic(fmt.Errorf("%s unsupported order for strings: '%s'", ERR_PREFIX, orderIdent))
+		}
+	case Int:
+		hasSameType = func(e Value) bool {
+			_, ok := e.(Int)
+			return ok
+		}
+		switch order {
+		case symbolic.AscendingOrder:
+			less = func(a, b Serializable) bool {
+				return a.(Int) < b.(Int)
+			}
+		case symbolic.DescendingOrder:
+			less = func(a, b Serializable) bool {
+				return a.(Int) > b.(Int)
+			}
+		default:
+			panic(fmt.Errorf("%s unsupported order for integers: '%s'", ERR_PREFIX, orderIdent))
+		}
+	case Float:
+		hasSameType = func(e Value) bool {
+			_, ok := e.(Float)
+			return ok
+		}
+		switch order {
+		case symbolic.AscendingOrder:
+			less = func(a, b Serializable) bool {
+				return a.(Float) < b.(Float)
+			}
+		case symbolic.DescendingOrder:
+			less = func(a, b Serializable) bool {
+				return a.(Float) > b.(Float)
+			}
+		default:
+			panic(fmt.Errorf("%s unsupported order for floats: '%s'", ERR_PREFIX, orderIdent))
+		}
+	default:
+		panic(fmt.Errorf("%s a(n) %T cannot be inserted in a sorted list", ERR_PREFIX, v))
+	}
+
+	for i := 0; i < l.Len(); i++ {
+		if elem := l.At(ctx, i); !hasSameType(elem) {
+			panic(fmt.Errorf("%s a(n) %T cannot be inserted in a list containing a(n) %T", ERR_PREFIX, v, elem))
+		}
+	}
+
+	l.insertSorted(ctx, v, less)
+}
+
 func (l *ValueList) SortByNestedValue(ctx *Context, path ValuePath, order Order) error {
 	if l.Len() <= 1 {
 		return nil
@@ -1156,6 +1156,28 @@ func TestCheck(t *testing.T) {
 			assert.NoError(t, staticCheckNoData(StaticCheckInput{Node: n, Chunk: src}))
 		})
 
+		t.Run("dynamic entry with invalid key", func(t *testing.T) {
+			n, src := mustParseCode(`Mapping { n ({}) => n }`)
+
+			obj := parse.FindNode(n, (*parse.ObjectLiteral)(nil), nil)
+			err := staticCheckNoData(StaticCheckInput{Node: n, Chunk: src})
+			expectedErr := utils.CombineErrors(
+				makeError(obj, src, INVALID_MAPPING_ENTRY_KEY_ONLY_SIMPL_LITS_AND_PATT_IDENTS),
+			)
+			assert.Equal(t, expectedErr, err)
+		})
+
+		t.Run("static entry with list key", func(t *testing.T) {
+			n, src := mustParseCode(`Mapping { [1] => 1 }`)
+
+			list := parse.FindNode(n, (*parse.ListLiteral)(nil), nil)
+			err := staticCheckNoData(StaticCheckInput{Node: n, Chunk: src})
+			expectedErr := utils.CombineErrors(
+				makeError(list, src, INVALID_MAPPING_ENTRY_KEY_ONLY_SIMPL_LITS_AND_PATT_IDENTS),
+			)
+			assert.Equal(t, expectedErr, err)
+		})
+
 		t.Run("dynamic entry with pattern identifier key ", func(t *testing.T) {
 			n, src := mustParseCode(`Mapping { n %int => 1 }`)
 
@@ -3343,6 +3365,62 @@ func TestCheck(t *testing.T) {
 			assert.Equal(t, expectedErr, err)
 		})
 
+		t.Run("single included file with no dependencies: duplicate function declaration", func(t *testing.T) {
+			moduleName := "mymod.ix"
+			modpath := writeModuleAndIncludedFiles(t, moduleName, `
+				manifest {}
+				fn f(){}
+				import ./dep.ix
+			`, map[string]string{"./dep.ix": "includable-file\n fn f(){}"})
+
+			mod, err := ParseLocalModule(modpath, ModuleParsingConfig{Context: createParsingContext(modpath)})
+			assert.NoError(t, err)
+			err = staticCheckNoData(StaticCheckInput{
+				Module: mod,
+				Node:   mod.MainChunk.Node,
+				Chunk:  mod.MainChunk,
+			})
+
+			expectedErr := utils.CombineErrors(
+				NewStaticCheckError(fmtCannotShadowGlobalVariable("f"), parse.SourcePositionStack{
+					parse.SourcePositionRange{
+						SourceName:  mod.MainChunk.Name(),
+						StartLine:   4,
+						StartColumn: 5,
+					},
+				}),
+			)
+			assert.Equal(t, expectedErr, err)
+		})
+
+		t.Run("single included file with no dependencies: function with the name of a global variable", func(t *testing.T) {
+			moduleName := "mymod.ix"
+			modpath := writeModuleAndIncludedFiles(t, moduleName, `
+				manifest {}
+				globalvar f = 1
+				import ./dep.ix
+			`, map[string]string{"./dep.ix": "includable-file\n fn f(){}"})
+
+			mod, err := ParseLocalModule(modpath, ModuleParsingConfig{Context: createParsingContext(modpath)})
+			assert.NoError(t, err)
+			err = staticCheckNoData(StaticCheckInput{
+				Module: mod,
+				Node:   mod.MainChunk.Node,
+				Chunk:  mod.MainChunk,
+			})
+
+			expectedErr := utils.CombineErrors(
+				NewStaticCheckError(fmtCannotShadowGlobalVariable("f"), parse.SourcePositionStack{
+					parse.SourcePositionRange{
+						SourceName:  mod.MainChunk.Name(),
+						StartLine:   4,
+						StartColumn: 5,
+					},
+				}),
+			)
+			assert.Equal(t, expectedErr, err)
+		})
+
 		t.Run("single included file which itself includes a file", func(t *testing.T) {
 			moduleName := "mymod.ix"
 			modpath := writeModuleAndIncludedFiles(t, moduleName, `
@@ -3820,6 +3898,19 @@ func TestCheck(t *testing.T) {
 			)
 			assert.Equal(t, expectedErr, err)
 		})
+
+		t.Run("top level", func(t *testing.T) {
+			n, src := mustParseCode(`
+				yield 1
+			`)
+
+			yieldStmt := parse.FindNode(n, (*parse.YieldStatement)(nil), nil)
+			err := staticCheckNoData(StaticCheckInput{Node: n, Chunk: src})
+			expectedErr := utils.CombineErrors(
+				makeError(yieldStmt, src, MISPLACE_YIELD_STATEMENT_ONLY_ALLOWED_IN_EMBEDDED_MODULES),
+			)
+			assert.Equal(t, expectedErr, err)
+		})
 	})
 
 	t.Run("break statement", func(t *testing.T) {
@@ -1907,13 +1907,28 @@ func (patt *RegexPattern) FindMatches(ctx *Context, val Serializable, config Mat
 	return FindMatchesForStringPattern(ctx, patt, val, config)
 }
 
+// MatchGroups returns the whole string (group "0") and the submatches of the capturing groups if v matches the pattern.
+// The submatch of a capturing group is available under its position ("1", "2", ...) and under its name if the group is named.
 func (patt *RegexPattern) MatchGroups(ctx *Context, v Serializable) (map[string]Serializable, bool, error) {
-	_, ok := v.(StringLike)
+	s, ok := v.(StringLike)
 	if !ok || !patt.Test(ctx, v) {
 		return nil, false, nil
 	}
 
-	return map[string]Serializable{"0": v}, true, nil
+	groups := map[string]Serializable{"0": v}
+
+	submatches := patt.regexp.FindStringSubmatch(s.GetOrBuildString())
+	groupNames := patt.regexp.SubexpNames()
+
+	for i := 1; i < len(submatches); i++ {
+		submatch := String(submatches[i])
+		groups[strconv.Itoa(i)] = submatch
+		if groupNames[i] != "" {
+			groups[groupNames[i]] = submatch
+		}
+	}
+
+	return groups, true, nil
 }
 
 func (patt *RegexPattern) LengthRange() IntRange {
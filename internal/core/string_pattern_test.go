@@ -1221,4 +1221,26 @@ func TestRegexPattern(t *testing.T) {
 		}
 	})
 
+	t.Run(".MatchGroups()", func(t *testing.T) {
+		patt := NewRegexPattern(`^(?P<year>\d{4})-(\d{2})$`)
+
+		t.Run("matching string", func(t *testing.T) {
+			result, ok, err := patt.MatchGroups(nil, String("2024-01"))
+			assert.NoError(t, err)
+			assert.True(t, ok)
+			assert.Equal(t, map[string]Serializable{
+				"0":    String("2024-01"),
+				"1":    String("2024"),
+				"year": String("2024"),
+				"2":    String("01"),
+			}, result)
+		})
+
+		t.Run("non-matching string", func(t *testing.T) {
+			result, ok, err := patt.MatchGroups(nil, String("2024"))
+			assert.NoError(t, err)
+			assert.False(t, ok)
+			assert.Nil(t, result)
+		})
+	})
 }
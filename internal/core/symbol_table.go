@@ -1,5 +1,7 @@
 package core
 
+import "slices"
+
 // A symbolScope represents a symbol scope during compilation.
 type symbolScope int
 
@@ -19,6 +21,7 @@ type symbol struct {
 type symbolTable struct {
 	store           map[string]*symbol
 	nextSymbolIndex int
+	slotNames       []string //name of the symbol defined for each index
 }
 
 func newSymbolTable() *symbolTable {
@@ -35,6 +38,7 @@ func (t *symbolTable) Define(name string) *symbol {
 		Index: nextIndex,
 	}
 	t.nextSymbolIndex++
+	t.slotNames = append(t.slotNames, name)
 
 	t.store[name] = symbol
 	return symbol
@@ -50,6 +54,12 @@ func (t *symbolTable) SymbolCount() int {
 	return t.nextSymbolIndex
 }
 
+// SlotNames returns the name of the symbol defined for each index, if a name has been defined several times
+// it is present at several positions.
+func (t *symbolTable) SlotNames() []string {
+	return slices.Clone(t.slotNames)
+}
+
 func (t *symbolTable) SymbolNames() []string {
 	var names []string
 	for name := range t.store {
@@ -35,6 +35,12 @@ var (
 	// mapping symbolic Go function -> reflect.Value of the concrete Go Function.
 	goFunctionMap = map[*symbolic.GoFunction]reflect.Value{}
 
+	// mapping Go type -> custom conversion function, see RegisterSymbolicConverter.
+	symbolicConverters     = map[reflect.Type]SymbolicConverterFn{}
+	symbolicConvertersLock sync.RWMutex
+
+	ErrNonUniqueSymbolicConverterRegistration = errors.New("non unique symbolic converter registration")
+
 	SYMBOLIC_DATA_PROP_NAMES = []string{"errors"}
 )
 
@@ -121,9 +127,17 @@ func init() {
 			return checkDatabaseSchema(objectPattern.(*ObjectPattern))
 		},
 
-		EstimatePermissionsFromListingNode: func(n *parse.ObjectLiteral) (any, error) {
-			perms, err := estimatePermissionsFromListingNode(n)
-			return perms, err
+		EstimateLThreadPermissions: func(n *parse.SpawnExpression, parentCtx symbolic.ConcreteContext) (any, error) {
+			ctx, ok := parentCtx.(*Context)
+			if !ok {
+				//the permissions of the parent are unknown.
+				return nil, nil
+			}
+			perms, err := EstimateLThreadPermissions(n, ctx)
+			if err != nil {
+				return nil, err
+			}
+			return perms, nil
 		},
 
 		CreateConcreteContext: func(permissions any) symbolic.ConcreteContext {
@@ -480,6 +494,31 @@ func (*SymbolicData) PropertyNames(ctx *Context) []string {
 	return SYMBOLIC_DATA_PROP_NAMES
 }
 
+// A SymbolicConverterFn converts a concrete value to a symbolic value, it is called instead of the value's
+// ToSymbolicValue method.
+type SymbolicConverterFn func(v any) (symbolic.Value, error)
+
+// RegisterSymbolicConverter registers a function converting the values of type goType to symbolic values,
+// the function is consulted by ToSymbolicValue before the ToSymbolicValue method of the value. This function
+// is safe for concurrent use but registrations should preferably happen during the initialization phase.
+func RegisterSymbolicConverter(goType reflect.Type, fn SymbolicConverterFn) {
+	symbolicConvertersLock.Lock()
+	defer symbolicConvertersLock.Unlock()
+
+	if _, ok := symbolicConverters[goType]; ok {
+		panic(fmt.Errorf("%w: %s", ErrNonUniqueSymbolicConverterRegistration, goType))
+	}
+	symbolicConverters[goType] = fn
+}
+
+func getSymbolicConverter(goType reflect.Type) (SymbolicConverterFn, bool) {
+	symbolicConvertersLock.RLock()
+	defer symbolicConvertersLock.RUnlock()
+
+	fn, ok := symbolicConverters[goType]
+	return fn, ok
+}
+
 func ToSymbolicValue(ctx *Context, v Value, wide bool) (symbolic.Value, error) {
 	return _toSymbolicValue(ctx, v, wide, make(map[uintptr]symbolic.Value))
 }
@@ -1028,12 +1067,7 @@ func (p *TypePattern) ToSymbolicVal() symbolic.Pattern {
 		switch patt.(type) {
 		case *TypePattern:
 			if SamePointer(p, patt) {
-				return symbolic.NewTypePattern(
-					p.SymbolicValue,
-					p.SymbolicCallImpl,
-					p.symbolicStringPattern,
-					p,
-				)
+				return p.newSymbolicTypePattern()
 			}
 		}
 	}
@@ -1042,12 +1076,7 @@ func (p *TypePattern) ToSymbolicVal() symbolic.Pattern {
 			switch patt.(type) {
 			case *TypePattern:
 				if SamePointer(p, patt) {
-					return symbolic.NewTypePattern(
-						p.SymbolicValue,
-						p.SymbolicCallImpl,
-						p.symbolicStringPattern,
-						p,
-					)
+					return p.newSymbolicTypePattern()
 				}
 			}
 		}
@@ -1056,18 +1085,26 @@ func (p *TypePattern) ToSymbolicVal() symbolic.Pattern {
 		switch patt.(type) {
 		case *TypePattern:
 			if SamePointer(p, patt) {
-				return symbolic.NewTypePattern(
-					p.SymbolicValue,
-					p.SymbolicCallImpl,
-					p.symbolicStringPattern,
-					p,
-				)
+				return p.newSymbolicTypePattern()
 			}
 		}
 	}
 	return symbolic.ANY_PATTERN
 }
 
+func (p *TypePattern) newSymbolicTypePattern() *symbolic.TypePattern {
+	symbolicPattern := symbolic.NewTypePattern(
+		p.SymbolicValue,
+		p.SymbolicCallImpl,
+		p.symbolicStringPattern,
+		p,
+	)
+	if p.SymbolicCallArity != nil {
+		symbolicPattern.SetCallArity(*p.SymbolicCallArity)
+	}
+	return symbolicPattern
+}
+
 func (p NamedSegmentPathPattern) ToSymbolicValue(ctx *Context, encountered map[uintptr]symbolic.Value) (symbolic.Value, error) {
 	return symbolic.NewNamedSegmentPathPattern(p.node), nil
 }
@@ -1228,19 +1265,27 @@ func (r QuantityRange) ToSymbolicValue(ctx *Context, encountered map[uintptr]sym
 
 func (r IntRange) ToSymbolicValue(ctx *Context, encountered map[uintptr]symbolic.Value) (symbolic.Value, error) {
 	if r.unknownStart {
-		return symbolic.ANY_INT_RANGE, nil
+		return symbolic.NewUpperBoundIntRange(symbolic.NewInt(r.end)), nil
+	}
+
+	if r.step != 1 {
+		return symbolic.NewSteppedIntRange(
+			symbolic.NewInt(r.start),
+			symbolic.NewInt(r.end),
+			symbolic.NewInt(r.step),
+		), nil
 	}
 
 	return symbolic.NewIntRange(
 		symbolic.NewInt(r.start),
 		symbolic.NewInt(r.end),
-		r.step != 1,
+		false,
 	), nil
 }
 
 func (r FloatRange) ToSymbolicValue(ctx *Context, encountered map[uintptr]symbolic.Value) (symbolic.Value, error) {
 	if r.unknownStart {
-		return symbolic.ANY_FLOAT_RANGE, nil
+		return symbolic.NewUpperBoundFloatRange(symbolic.NewFloat(r.end), r.inclusiveEnd), nil
 	}
 
 	return symbolic.NewFloatRange(
@@ -1445,11 +1490,17 @@ func (c *BytesConcatenation) ToSymbolicValue(ctx *Context, encountered map[uintp
 }
 
 func (s *TestSuite) ToSymbolicValue(ctx *Context, encountered map[uintptr]symbolic.Value) (symbolic.Value, error) {
-	return &symbolic.TestSuite{}, nil
+	if name, ok := s.ItemName(); ok {
+		return symbolic.NewTestSuite(symbolic.NewString(name)), nil
+	}
+	return symbolic.NewTestSuite(nil), nil
 }
 
 func (c *TestCase) ToSymbolicValue(ctx *Context, encountered map[uintptr]symbolic.Value) (symbolic.Value, error) {
-	return &symbolic.TestCase{}, nil
+	if name, ok := c.ItemName(); ok {
+		return symbolic.NewTestCase(symbolic.NewString(name)), nil
+	}
+	return symbolic.NewTestCase(nil), nil
 }
 
 func (r *TestCaseResult) ToSymbolicValue(ctx *Context, encountered map[uintptr]symbolic.Value) (symbolic.Value, error) {
@@ -1512,7 +1563,16 @@ func _toSymbolicValue(ctx *Context, v Value, wide bool, encountered map[uintptr]
 		return symbolic.Nil, nil
 	}
 
-	e, err := v.ToSymbolicValue(ctx, encountered)
+	var (
+		e   symbolic.Value
+		err error
+	)
+
+	if converter, ok := getSymbolicConverter(rval.Type()); ok {
+		e, err = converter(v)
+	} else {
+		e, err = v.ToSymbolicValue(ctx, encountered)
+	}
 	if err != nil {
 		return nil, err
 	}
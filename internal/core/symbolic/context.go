@@ -3,9 +3,11 @@ package symbolic
 import (
 	"context"
 	"fmt"
+	"strings"
 
 	"github.com/inoxlang/inox/internal/core/permkind"
 	"github.com/inoxlang/inox/internal/parse"
+	"github.com/inoxlang/inox/internal/utils"
 	"golang.org/x/exp/maps"
 	"golang.org/x/exp/slices"
 )
@@ -27,6 +29,15 @@ type Context struct {
 	patternNamespaces                   map[string]*PatternNamespace
 	patternNamespacePositionDefinitions map[string]parse.SourcePositionRange
 	typeExtensions                      []*TypeExtension
+
+	//permissions dropped by permission dropping statements, only permissions without a specific
+	//resource (e.g. [create threads]) are tracked.
+	droppedPermissions []droppedPermission
+}
+
+type droppedPermission struct {
+	kind     permkind.PermissionKind
+	typename permkind.InternalPermissionTypename
 }
 
 func NewSymbolicContext(startingConcreteContext, concreteContext ConcreteContext, parentContext *Context) *Context {
@@ -64,6 +75,25 @@ func (ctx *Context) ResolveNamedPattern(name string) Pattern {
 	return pattern
 }
 
+// ResolveNamedPatternOrSuggest returns the pattern with the given name, if there is no such pattern nil is returned
+// along with the name of the closest pattern (empty if no pattern name is close enough).
+func (ctx *Context) ResolveNamedPatternOrSuggest(name string) (Pattern, string) {
+	if pattern := ctx.ResolveNamedPattern(name); pattern != nil {
+		return pattern, ""
+	}
+
+	var names []string
+	ctx.ForEachPattern(func(name string, _ Pattern, _ bool, _ parse.SourcePositionRange) {
+		names = append(names, name)
+	})
+
+	closest, _, ok := utils.FindClosestString(ctx.startingConcreteContext, names, name, 2)
+	if !ok {
+		return nil, ""
+	}
+	return nil, closest
+}
+
 func (ctx *Context) AllNamedPatternNames() []string {
 	return maps.Keys(ctx.namedPatterns)
 }
@@ -91,6 +121,36 @@ func (ctx *Context) ForEachPattern(fn func(name string, pattern Pattern, knowPos
 	}
 }
 
+// ResolveOptionPattern returns the named option pattern (e.g. %--verbose=%bool) matching options with the given name.
+// If several named patterns match, the pattern is ambiguous: no pattern is returned and the sorted names of the matching
+// patterns are returned instead.
+func (ctx *Context) ResolveOptionPattern(optionName string) (pattern *OptionPattern, ambiguousPatternNames []string) {
+	matchingPatterns := map[string]*OptionPattern{}
+
+	ctx.ForEachPattern(func(name string, pattern Pattern, _ bool, _ parse.SourcePositionRange) {
+		optionPattern, ok := pattern.(*OptionPattern)
+		if ok && optionPattern.name == optionName {
+			matchingPatterns[name] = optionPattern
+		} else {
+			//pattern shadowing a pattern of the forking parent.
+			delete(matchingPatterns, name)
+		}
+	})
+
+	switch len(matchingPatterns) {
+	case 0:
+		return nil, nil
+	case 1:
+		for _, pattern := range matchingPatterns {
+			return pattern, nil
+		}
+	}
+
+	ambiguousPatternNames = maps.Keys(matchingPatterns)
+	slices.Sort(ambiguousPatternNames)
+	return nil, ambiguousPatternNames
+}
+
 func (ctx *Context) CopyNamedPatternsIn(destCtx *Context) {
 	if ctx.forkingParent != nil {
 		ctx.forkingParent.CopyNamedPatternsIn(destCtx)
@@ -175,6 +235,33 @@ func (ctx *Context) GetExtensions(v Value) (extensions []*TypeExtension) {
 	return
 }
 
+// ExtensionsFor returns the extensions whose extended pattern matches $v, the result is sorted by extension id.
+func (ctx *Context) ExtensionsFor(v Value) []*TypeExtension {
+	extensions := ctx.GetExtensions(v)
+	slices.SortStableFunc(extensions, func(a, b *TypeExtension) int {
+		return strings.Compare(a.Id, b.Id)
+	})
+	return extensions
+}
+
+// IsPropertyDefinedByExtension returns true if an extension of $pattern (or of an equivalent pattern)
+// already defines a property named $name.
+func (ctx *Context) IsPropertyDefinedByExtension(pattern Pattern, name string) bool {
+	for _, extension := range ctx.typeExtensions {
+		extendedPattern := extension.ExtendedPattern
+		if !extendedPattern.Test(pattern, RecTestCallState{}) || !pattern.Test(extendedPattern, RecTestCallState{}) {
+			continue
+		}
+
+		for _, propExpr := range extension.PropertyExpressions {
+			if propExpr.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func (ctx *Context) CopyTypeExtensions(destCtx *Context) {
 	for _, extension := range ctx.typeExtensions {
 		destCtx.AddTypeExtension(extension)
@@ -213,16 +300,63 @@ func (ctx *Context) HasPermission(perm any) bool {
 	if ctx.isolatedConcreteContext == nil {
 		return false
 	}
+
+	if perm, ok := perm.(interface {
+		Kind() permkind.PermissionKind
+		InternalPermTypename() permkind.InternalPermissionTypename
+	}); ok && ctx.isDropped(perm.Kind(), perm.InternalPermTypename()) {
+		return false
+	}
+
 	return ctx.isolatedConcreteContext.HasPermissionUntyped(perm)
 }
 
+func (ctx *Context) HasAnyPermission(perms ...any) bool {
+	for _, perm := range perms {
+		if ctx.HasPermission(perm) {
+			return true
+		}
+	}
+	return false
+}
+
+func (ctx *Context) HasAllPermissions(perms ...any) bool {
+	for _, perm := range perms {
+		if !ctx.HasPermission(perm) {
+			return false
+		}
+	}
+	return true
+}
+
 func (ctx *Context) HasAPermissionWithKindAndType(kind permkind.PermissionKind, name permkind.InternalPermissionTypename) bool {
-	if ctx.isolatedConcreteContext == nil {
+	if ctx.isolatedConcreteContext == nil || ctx.isDropped(kind, name) {
 		return false
 	}
 	return ctx.isolatedConcreteContext.HasAPermissionWithKindAndType(kind, name)
 }
 
+// DropPermissions models the dropping of the permissions of a given kind and type (e.g. [create threads]):
+// the permissions of minor kinds are dropped as well if kind is a major permission kind.
+func (ctx *Context) DropPermissions(kind permkind.PermissionKind, typenames ...permkind.InternalPermissionTypename) {
+	for _, typename := range typenames {
+		ctx.droppedPermissions = append(ctx.droppedPermissions, droppedPermission{kind: kind, typename: typename})
+	}
+}
+
+func (ctx *Context) isDropped(kind permkind.PermissionKind, typename permkind.InternalPermissionTypename) bool {
+	for _, dropped := range ctx.droppedPermissions {
+		if dropped.typename == typename && dropped.kind.Includes(kind) {
+			return true
+		}
+	}
+
+	if ctx.forkingParent != nil {
+		return ctx.forkingParent.isDropped(kind, typename)
+	}
+	return false
+}
+
 func (ctx *Context) currentData() (data ContextData) {
 	//TODO: share some pieces of data between ContextData values in order to save memor
 	//forking makes that non trivial
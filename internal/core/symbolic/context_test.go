@@ -1,8 +1,11 @@
 package symbolic
 
 import (
+	"context"
+	"slices"
 	"testing"
 
+	"github.com/inoxlang/inox/internal/core/permkind"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -19,4 +22,90 @@ func TestSymbolicContext(t *testing.T) {
 			assert.Nil(t, ctx.ResolveNamedPattern("p"))
 		})
 	})
+	t.Run("ResolveNamedPatternOrSuggest()", func(t *testing.T) {
+		ctx := NewSymbolicContext(nil, nil, nil)
+		ctx.AddNamedPattern("user", &TypePattern{val: ANY_OBJ}, false)
+		fork := ctx.fork()
+
+		pattern, suggestion := fork.ResolveNamedPatternOrSuggest("user")
+		assert.Equal(t, &TypePattern{val: ANY_OBJ}, pattern)
+		assert.Empty(t, suggestion)
+
+		//misspelled name
+		pattern, suggestion = fork.ResolveNamedPatternOrSuggest("usr")
+		assert.Nil(t, pattern)
+		assert.Equal(t, "user", suggestion)
+
+		//no close name
+		pattern, suggestion = fork.ResolveNamedPatternOrSuggest("integer")
+		assert.Nil(t, pattern)
+		assert.Empty(t, suggestion)
+	})
+
+	t.Run("ExtensionsFor()", func(t *testing.T) {
+		ctx := NewSymbolicContext(nil, nil, nil)
+
+		intExtension := &TypeExtension{Id: "b", ExtendedPattern: &TypePattern{val: ANY_INT}}
+		serializableExtension := &TypeExtension{Id: "a", ExtendedPattern: &TypePattern{val: ANY_SERIALIZABLE}}
+		strExtension := &TypeExtension{Id: "c", ExtendedPattern: ANY_STR_PATTERN}
+
+		ctx.AddTypeExtension(intExtension)
+		ctx.AddTypeExtension(serializableExtension)
+		ctx.AddTypeExtension(strExtension)
+
+		assert.Equal(t, []*TypeExtension{serializableExtension, intExtension}, ctx.ExtensionsFor(ANY_INT))
+		assert.Equal(t, []*TypeExtension{serializableExtension, strExtension}, ctx.ExtensionsFor(ANY_STRING))
+		assert.Empty(t, ctx.ExtensionsFor(ANY))
+	})
+
+	t.Run("HasAnyPermission() & HasAllPermissions()", func(t *testing.T) {
+		concreteCtx := testConcreteContext{Context: context.Background(), grantedPermissions: []string{"read", "write"}}
+		ctx := NewSymbolicContext(concreteCtx, concreteCtx, nil)
+
+		assert.True(t, ctx.HasAnyPermission("read", "delete"))
+		assert.False(t, ctx.HasAnyPermission("delete"))
+		assert.True(t, ctx.HasAllPermissions("read", "write"))
+		assert.False(t, ctx.HasAllPermissions("read", "delete"))
+
+		//no concrete context
+		ctx = NewSymbolicContext(nil, nil, nil)
+		assert.False(t, ctx.HasAnyPermission("read"))
+		assert.False(t, ctx.HasAllPermissions("read"))
+	})
+
+	t.Run("DropPermissions()", func(t *testing.T) {
+		concreteCtx := testConcreteContext{
+			Context: context.Background(),
+			grantedPermissionTypes: map[permkind.InternalPermissionTypename]permkind.PermissionKind{
+				permkind.LTHREAD_PERM_TYPENAME:  permkind.Write,
+				permkind.SYSGRAPH_PERM_TYPENAME: permkind.Read,
+			},
+		}
+		ctx := NewSymbolicContext(concreteCtx, concreteCtx, nil)
+		fork := ctx.fork()
+
+		assert.True(t, ctx.HasAPermissionWithKindAndType(permkind.Create, permkind.LTHREAD_PERM_TYPENAME))
+
+		ctx.DropPermissions(permkind.Write, permkind.LTHREAD_PERM_TYPENAME)
+
+		//minor permission kinds are dropped as well.
+		assert.False(t, ctx.HasAPermissionWithKindAndType(permkind.Create, permkind.LTHREAD_PERM_TYPENAME))
+		assert.False(t, fork.HasAPermissionWithKindAndType(permkind.Create, permkind.LTHREAD_PERM_TYPENAME))
+		assert.True(t, ctx.HasAPermissionWithKindAndType(permkind.Read, permkind.SYSGRAPH_PERM_TYPENAME))
+	})
+}
+
+type testConcreteContext struct {
+	context.Context
+	grantedPermissions     []string
+	grantedPermissionTypes map[permkind.InternalPermissionTypename]permkind.PermissionKind
+}
+
+func (ctx testConcreteContext) HasPermissionUntyped(perm any) bool {
+	return slices.Contains(ctx.grantedPermissions, perm.(string))
+}
+
+func (ctx testConcreteContext) HasAPermissionWithKindAndType(kind permkind.PermissionKind, typename permkind.InternalPermissionTypename) bool {
+	grantedKind, ok := ctx.grantedPermissionTypes[typename]
+	return ok && grantedKind.Includes(kind)
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/inoxlang/inox/internal/parse"
 	pprint "github.com/inoxlang/inox/internal/prettyprint"
 	"github.com/inoxlang/inox/internal/utils"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -31,6 +32,12 @@ type Data struct {
 
 	comptimeTypes map[ /* *Chunk or *EmbeddModule */ parse.Node]*ModuleCompileTimeTypes
 
+	//global scope of the main chunk at the end of its evaluation.
+	topLevelGlobalScopeData *ScopeData
+
+	//results of the checking of imported modules, the keys are the main chunks of the modules.
+	importedModuleChecks map[*parse.Chunk]*importedModuleCheck
+
 	errorMessageSet map[string]bool
 	errors          []SymbolicEvaluationError
 
@@ -52,7 +59,8 @@ func NewSymbolicData() *Data {
 		typeExtensions:              make(map[*parse.DoubleColonExpression][]*TypeExtension, 0),
 		urlReferencedEntities:       make(map[*parse.DoubleColonExpression]Value, 0),
 
-		comptimeTypes: make(map[parse.Node]*ModuleCompileTimeTypes, 0),
+		comptimeTypes:        make(map[parse.Node]*ModuleCompileTimeTypes, 0),
+		importedModuleChecks: make(map[*parse.Chunk]*importedModuleCheck, 0),
 
 		errorMessageSet:   make(map[string]bool, 0),
 		warningMessageSet: make(map[string]bool, 0),
@@ -206,6 +214,10 @@ func (data *Data) AddData(newData *Data) {
 		data.SetGlobalScopeData(k, v)
 	}
 
+	if newData.topLevelGlobalScopeData != nil {
+		data.SetTopLevelGlobalScopeData(*newData.topLevelGlobalScopeData)
+	}
+
 	for k, v := range newData.contextData {
 		data.SetContextData(k, v)
 	}
@@ -234,10 +246,110 @@ func (data *Data) AddData(newData *Data) {
 		data.SetURLReferencedEntity(k, v)
 	}
 
+	for k, v := range newData.importedModuleChecks {
+		data.importedModuleChecks[k] = v
+	}
+
 	data.errors = append(data.errors, newData.errors...)
 	data.warnings = append(data.warnings, newData.warnings...)
 }
 
+// An importedModuleCheck records the result of the checking of an imported module, it allows
+// the data of unchanged imported modules to be reused by later checks (see EvalCheckInput.PreviousData).
+type importedModuleCheck struct {
+	importPositions []parse.SourcePositionRange
+
+	//main chunk, included chunks and chunks of (transitively) imported modules.
+	chunks []*parse.Chunk
+
+	errors   []SymbolicEvaluationError
+	warnings []SymbolicEvaluationWarning
+
+	//value of the import identifier
+	result Value
+}
+
+func (c *importedModuleCheck) isReusable(importPositions []parse.SourcePositionRange, chunks []*parse.Chunk) bool {
+	return slices.Equal(c.importPositions, importPositions) && slices.Equal(c.chunks, chunks)
+}
+
+// reuseImportedModuleCheck copies the data of the nodes of the checked chunks, and the errors & warnings of the check.
+func (data *Data) reuseImportedModuleCheck(previousData *Data, check *importedModuleCheck) error {
+	for _, chunk := range check.chunks {
+		if types, ok := previousData.comptimeTypes[chunk]; ok {
+			data.comptimeTypes[chunk] = types
+		}
+
+		err := parse.Walk(chunk, func(node, parent, scopeNode parse.Node, ancestorChain []parse.Node, after bool) (parse.TraversalAction, error) {
+			data.copyNodeData(previousData, node)
+			return parse.ContinueTraversal, nil
+		}, nil)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, err := range check.errors {
+		data.AddError(err)
+	}
+
+	for _, warning := range check.warnings {
+		data.AddWarning(warning)
+	}
+
+	data.importedModuleChecks[check.chunks[0]] = check
+	return nil
+}
+
+func (data *Data) copyNodeData(previousData *Data, node parse.Node) {
+	if v, ok := previousData.mostSpecificNodeValues[node]; ok {
+		data.SetMostSpecificNodeValue(node, v)
+	}
+
+	if v, ok := previousData.lessSpecificNodeValues[node]; ok {
+		data.SetLessSpecificNodeValue(node, v)
+	}
+
+	if v, ok := previousData.localScopeData[node]; ok {
+		data.SetLocalScopeData(node, v)
+	}
+
+	if v, ok := previousData.globalScopeData[node]; ok {
+		data.SetGlobalScopeData(node, v)
+	}
+
+	if v, ok := previousData.contextData[node]; ok {
+		data.SetContextData(node, v)
+	}
+
+	if v, ok := previousData.allowedNonPresentProperties[node]; ok {
+		data.SetAllowedNonPresentProperties(node, v)
+	}
+
+	if v, ok := previousData.allowedNonPresentKeys[node]; ok {
+		data.SetAllowedNonPresentKeys(node, v)
+	}
+
+	if v, ok := previousData.runtimeTypeCheckPatterns[node]; ok {
+		data.SetRuntimeTypecheckPattern(node, v)
+	}
+
+	if expr, ok := node.(*parse.DoubleColonExpression); ok {
+		if v, ok := previousData.usedTypeExtensions[expr]; ok {
+			data.SetUsedTypeExtension(expr, v)
+		}
+
+		if v, ok := previousData.typeExtensions[expr]; ok {
+			data.SetAllTypeExtensions(expr, v)
+		}
+
+		if v, ok := previousData.urlReferencedEntities[expr]; ok {
+			data.SetURLReferencedEntity(expr, v)
+		}
+	}
+}
+
 func (d *Data) Test(v Value, state RecTestCallState) bool {
 	state.StartCall()
 	defer state.FinishCall()
@@ -368,6 +480,29 @@ func (d *Data) SetGlobalScopeData(n parse.Node, scopeData ScopeData) {
 	d.globalScopeData[n] = scopeData
 }
 
+// SetTopLevelGlobalScopeData sets the data about the global scope of the main chunk at the end of its evaluation.
+func (d *Data) SetTopLevelGlobalScopeData(scopeData ScopeData) {
+	if d == nil {
+		return
+	}
+
+	d.topLevelGlobalScopeData = &scopeData
+}
+
+// GlobalTypes returns the types of the global variables of the main chunk at the end of its evaluation,
+// nil is returned if no data has been set.
+func (d *Data) GlobalTypes() map[string]Value {
+	if d == nil || d.topLevelGlobalScopeData == nil {
+		return nil
+	}
+
+	types := make(map[string]Value, len(d.topLevelGlobalScopeData.Variables))
+	for _, variable := range d.topLevelGlobalScopeData.Variables {
+		types[variable.Name] = variable.Value
+	}
+	return types
+}
+
 func (d *Data) UpdateAllPreviousGlobalScopeDataWithInoxFunction(chunk *parse.Chunk, name string, value *InoxFunction) {
 	if d == nil {
 		return
@@ -4,7 +4,7 @@ import pprint "github.com/inoxlang/inox/internal/prettyprint"
 
 var (
 	DICTIONARY_PROPNAMES = []string{"get", "set"}
-	LIST_PROPNAMES       = []string{"append", "dequeue", "pop", "remove_all", "sorted", "sort_by", "len"}
+	LIST_PROPNAMES       = []string{"append", "dequeue", "pop", "remove_all", "sorted", "sort_by", "insert_sorted", "chunk", "len"}
 
 	ANY_INDEXABLE    = &AnyIndexable{}
 	ANY_ARRAY        = NewArrayOf(ANY)
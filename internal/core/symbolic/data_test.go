@@ -0,0 +1,60 @@
+package symbolic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDataGlobalTypes(t *testing.T) {
+
+	t.Run("no data", func(t *testing.T) {
+		data := NewSymbolicData()
+		assert.Nil(t, data.GlobalTypes())
+	})
+
+	t.Run("declared globals", func(t *testing.T) {
+		n, state := MakeTestStateAndChunk(`
+			const (
+				A = 1
+			)
+			globalvar b = "b"
+			$$c = true
+		`)
+
+		_, err := symbolicEval(n, state)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, state.errors())
+
+		types := state.symbolicData.GlobalTypes()
+		assert.Equal(t, INT_1, types["A"])
+		assert.Equal(t, NewString("b"), types["b"])
+		assert.Equal(t, TRUE, types["c"])
+	})
+
+	t.Run("imported module", func(t *testing.T) {
+		n, state := MakeTestStateAndImportedModules(`
+			manifest {}
+			import res /lib.ix {}
+		`, map[string]string{
+			"/lib.ix": `
+				manifest {}
+				globalvar x = 1
+				return 1
+			`,
+		})
+
+		_, err := symbolicEval(n, state)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.Empty(t, state.errors())
+
+		//the globals of the imported module should not be present.
+		types := state.symbolicData.GlobalTypes()
+		assert.Contains(t, types, "res")
+		assert.NotContains(t, types, "x")
+	})
+}
@@ -107,14 +107,15 @@ func (dict *Dictionary) Concretize(ctx ConcreteContext) any {
 	concreteKeys := make([]any, len(dict.entries))
 
 	i := 0
-	for keyRepr, value := range dict.entries {
+	dict.ForEachEntrySorted(func(keyRepr string, value Value) error {
 		concreteValue := utils.Must(Concretize(value, ctx))
 		concreteKey := utils.Must(Concretize(dict.keys[keyRepr], ctx))
 
 		concreteValues[i] = concreteValue
 		concreteKeys[i] = concreteKey
 		i++
-	}
+		return nil
+	})
 	return extData.ConcreteValueFactories.CreateDictionary(concreteKeys, concreteValues, ctx)
 }
 
@@ -173,6 +174,19 @@ func (dict *Dictionary) ForEachEntry(fn func(k string, v Value) error) error {
 	return nil
 }
 
+// ForEachEntrySorted is like ForEachEntry but iterates over the entries in the lexicographic order of the key representations.
+func (dict *Dictionary) ForEachEntrySorted(fn func(k string, v Value) error) error {
+	keys := maps.Keys(dict.entries)
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if err := fn(k, dict.entries[k]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (dict *Dictionary) Prop(name string) Value {
 	switch name {
 	case "get":
@@ -212,14 +226,10 @@ func (dict *Dictionary) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.P
 
 		w.WriteString(":{")
 
-		var keys []string
-		for k := range dict.entries {
-			keys = append(keys, k)
-		}
-
-		sort.Strings(keys)
+		i := 0
+		entryCount := len(dict.entries)
 
-		for i, k := range keys {
+		dict.ForEachEntrySorted(func(k string, v Value) error {
 			if !config.Compact {
 				w.WriteLFCR()
 				w.WriteBytes(indent)
@@ -240,21 +250,20 @@ func (dict *Dictionary) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.P
 			w.WriteColonSpace()
 
 			//value
-			v := dict.entries[k]
-
 			v.PrettyPrint(w.IncrDepthWithIndent(indentCount), config)
 
 			//comma & indent
-			isLastEntry := i == len(keys)-1
+			isLastEntry := i == entryCount-1
 
 			if !isLastEntry {
 				w.WriteBytes([]byte{',', ' '})
 
 			}
+			i++
+			return nil
+		})
 
-		}
-
-		if !config.Compact && len(keys) > 0 {
+		if !config.Compact && entryCount > 0 {
 			w.WriteLFCR()
 		}
 		w.WriteBytes(bytes.Repeat(config.Indent, w.Depth))
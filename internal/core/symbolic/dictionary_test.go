@@ -113,4 +113,34 @@ func TestDictionary(t *testing.T) {
 		})
 	})
 
+	t.Run("ForEachEntrySorted()", func(t *testing.T) {
+		dict := NewDictionary(map[string]Serializable{
+			"./c": ANY_INT,
+			"./a": ANY_STRING,
+			"./b": ANY_BOOL,
+		}, map[string]Serializable{
+			"./c": NewPath("./c"),
+			"./a": NewPath("./a"),
+			"./b": NewPath("./b"),
+		})
+
+		for i := 0; i < 10; i++ {
+			var keys []string
+			var values []Value
+
+			err := dict.ForEachEntrySorted(func(k string, v Value) error {
+				keys = append(keys, k)
+				values = append(values, v)
+				return nil
+			})
+
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, []string{"./a", "./b", "./c"}, keys)
+			assert.Equal(t, []Value{ANY_STRING, ANY_BOOL, ANY_INT}, values)
+		}
+	})
+
 }
@@ -33,6 +33,8 @@ const (
 	CANNOT_SPREAD_OBJ_PATTERN_THAT_MATCHES_ANY_OBJECT = "cannot spread an object pattern that matches any object"
 	CANNOT_SPREAD_REC_PATTERN_THAT_MATCHES_ANY_RECORD = "cannot spread an record pattern that matches any record"
 	CANNOT_SPREAD_OBJ_PATTERN_THAT_IS_INEXACT         = "cannot spread an object pattern that is inexact"
+	SPREAD_INEXACT_OBJ_PATTERN_MAKES_RESULT_INEXACT   = "the spread object pattern is inexact, so the resulting pattern is inexact despite otherprops(no)"
+	SPREAD_INEXACT_REC_PATTERN_MAKES_RESULT_INEXACT   = "the spread record pattern is inexact, so the resulting pattern is inexact despite otherprops(no)"
 	SPREAD_ELEMENT_SHOULD_BE_A_LIST                   = "spread element should be a list"
 	SPREAD_ELEMENT_SHOULD_BE_A_TUPLE                  = "spread element should be a tuple"
 
@@ -74,7 +76,7 @@ const (
 	ELEM_PATTERNS_OF_TUPLE_SHOUD_MATCH_ONLY_IMMUTABLES = "element patterns of a tuple pattern should match only immutable values"
 	UNSUPPORTED_PARAM_TYPE_FOR_RUNTIME_TYPECHECK       = "unsupported parameter type for runtime typecheck"
 
-	CONCATENATION_SUPPORTED_TYPES_EXPLANATION = "only string, bytes & tuple concatenations are supported for now"
+	CONCATENATION_SUPPORTED_TYPES_EXPLANATION = "only string, bytes, tuple & list concatenations are supported for now"
 	SPREAD_ELEMENT_SHOULD_BE_ITERABLE         = "spread element in concenation should be iterable"
 
 	NESTED_RECURSIVE_FUNCTION_DECLARATION = "nested recursive function declarations are not allowed"
@@ -164,6 +166,7 @@ const (
 
 	CANNOT_POP_FROM_EMPTY_LIST     = "cannot pop() from an empty list"
 	CANNOT_DEQUEUE_FROM_EMPTY_LIST = "cannot dequeue() from an empty list"
+	CHUNK_SIZE_SHOULD_BE_POSITIVE  = "chunk size should be greater than zero"
 
 	//struct definition
 	ONLY_COMPILE_TIME_TYPES_CAN_BE_USED_AS_STRUCT_FIELD_TYPES = //
@@ -289,6 +292,14 @@ func fmtVarOfTypeCannotBeNarrowedToAn(variable Value, val Value) string {
 	return fmt.Sprintf("variable of type %s cannot be narrowed to a(n) %s", Stringify(variable), Stringify(val))
 }
 
+func fmtOptionValueNotMatchingDeclaredPattern(name string, v Value, pattern Pattern) string {
+	return fmt.Sprintf("value of option --%s should be a(n) %s but is a(n) %s", name, Stringify(pattern.SymbolicValue()), Stringify(v))
+}
+
+func fmtSeveralPatternsDeclaredForOption(name string, patternNames []string) string {
+	return fmt.Sprintf("the value of option --%s is not checked because several patterns are declared for the option: %%%s", name, strings.Join(patternNames, ", %"))
+}
+
 func fmtNotAssignableToPropOfType(a Value, b Value) string {
 	examples := GetExamples(b, ExampleComputationContext{NonMatchingValue: a})
 	examplesString := ""
@@ -424,6 +435,10 @@ func FmtInvalidArg(position int, actual, expected Value) string {
 	return fmt.Sprintf("invalid value for argument at position %d: type is %s, but %s was expected", position, Stringify(actual), Stringify(expected))
 }
 
+func fmtBodyExpressionOfFunctionEvaluatesToNil(expected Value) string {
+	return fmt.Sprintf("the body expression of the function evaluates to nil, but a value matching %v was expected", Stringify(expected))
+}
+
 func fmtInvalidReturnValue(actual, expected Value) string {
 	return fmt.Sprintf("invalid return value: type is %v, but a value matching %v was expected", Stringify(actual), Stringify(expected))
 }
@@ -488,6 +503,10 @@ func fmtLocalVarIsNotDeclared(name string) string {
 	return fmt.Sprintf("local variable '%s' is not declared", name)
 }
 
+func fmtCapturedLocalIsNeverRead(name string) string {
+	return fmt.Sprintf("captured local '%s' is never read in the function", name)
+}
+
 func fmtGlobalVarIsNotDeclared(name string) string {
 	return fmt.Sprintf("global variable '%s' is not declared", name)
 }
@@ -561,12 +580,16 @@ func fmtExtensionsDoNotProvideTheXProp(name string, suggestion string) string {
 	return fmt.Sprintf("extensions do not provide a(n) '%s' property%s", name, suggestion)
 }
 
-func fmtPatternSpreadInObjectPatternShouldBeAnObjectPatternNot(v Value) string {
-	return fmt.Sprintf("a pattern that is a spread in an object pattern should be an object pattern not a(n) %s", Stringify(v))
+func fmtPatternSpreadInObjectPatternShouldBeAnObjectOrRecordPatternNot(v Value) string {
+	return fmt.Sprintf("a pattern that is a spread in an object pattern should be an object pattern or a record pattern not a(n) %s", Stringify(v))
+}
+
+func fmtPatternSpreadInRecordPatternShouldBeARecordOrObjectPatternNot(v Value) string {
+	return fmt.Sprintf("a pattern that is a spread in a record pattern should be a record pattern or an object pattern not a(n) %s", Stringify(v))
 }
 
-func fmtPatternSpreadInRecordPatternShouldBeAnRecordPatternNot(v Value) string {
-	return fmt.Sprintf("a pattern that is a spread in an record pattern should be an record pattern not a(n) %s", Stringify(v))
+func fmtEntryOfObjectPatternSpreadInRecordPatternShouldMatchOnlyImmutableValues(name string) string {
+	return fmt.Sprintf("the object pattern cannot be spread in a record pattern: its entry '%s' matches mutable values", name)
 }
 
 func fmtPropertyShouldNotBePresentInSeveralSpreadPatterns(name string) string {
@@ -609,8 +632,20 @@ func FormatErrPropertyDoesNotExist(name string, v Value) error {
 	return fmt.Errorf("property .%s of value %#v does not exist", name, v)
 }
 
-func fmtSynchronizedValueShouldBeASharableValueOrImmutableNot(v Value) string {
-	return fmt.Sprintf("synchronized value should be a sharable or immutable value not a(n) %s", Stringify(v))
+func fmtSynchronizedValuesShouldBeSharableOrImmutable(values []Value) string {
+	if len(values) == 1 {
+		return fmt.Sprintf("synchronized value should be a sharable or immutable value not a(n) %s", Stringify(values[0]))
+	}
+
+	buf := bytes.NewBufferString("synchronized values should be sharable or immutable values, the following ones are not: ")
+	for i, v := range values {
+		if i != 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString("a(n) ")
+		buf.WriteString(Stringify(v))
+	}
+	return buf.String()
 }
 
 func fmtXisNotAGroupMatchingPattern(v Value) string {
@@ -637,6 +672,10 @@ func fmtUnknownSectionInLThreadMetadata(name string) string {
 	return fmt.Sprintf("unknown section '%s' in lthread metadata", name)
 }
 
+func fmtGlobalPassedToLThreadIsNotDefined(name string) string {
+	return fmt.Sprintf("global '%s' passed to the lthread is not defined", name)
+}
+
 func fmtValueNotStringifiableToQueryParamValue(val Value) string {
 	return fmt.Sprintf("value of type %s is not stringifiable to a query param value: only strings, integers & booleans are accepted", Stringify(val))
 }
@@ -645,6 +684,10 @@ func fmtVal1Val2HaveNoOverlap(val1, val2 Value) string {
 	return fmt.Sprintf("%s and %s have no overlap", Stringify(val1), Stringify(val2))
 }
 
+func fmtValueCannotBeConvertedTo(value Value, pattern Pattern) string {
+	return fmt.Sprintf("a(n) %s cannot be converted to %s", Stringify(value), Stringify(pattern))
+}
+
 func fmtStringConcatInvalidElementOfType(v Value) string {
 	return fmt.Sprintf("string concatenation: invalid element of type %s", Stringify(v))
 }
@@ -657,6 +700,10 @@ func fmtExtendedValueAlreadyHasAnXProperty(name string) string {
 	return fmt.Sprintf("extended value already has a(n) %q property", name)
 }
 
+func fmtAnotherExtensionAlreadyDefinesAnXProperty(name string) string {
+	return fmt.Sprintf("another extension of the same pattern already defines a(n) %q property", name)
+}
+
 func FmtPropertyPatternError(name string, err error) error {
 	return fmt.Errorf("property pattern .%s: %w", name, err)
 }
@@ -716,6 +763,15 @@ func fmtCompileTimeTypeIsNotDefined(name string) string {
 	return fmt.Sprintf("compile-time type '%s' is not defined, note that patterns are not compile-time types", name)
 }
 
+func fmtSwitchStatementDoesNotCoverValues(uncovered []Value) string {
+	return fmt.Sprintf("the switch statement has no default case and does not cover the following possible values: %s",
+		strings.Join(utils.MapSlice(uncovered, Stringify), ", "))
+}
+
+func fmtIntArithmeticOverflows(left int64, operator parse.BinaryOperator, right int64) string {
+	return fmt.Sprintf("integer overflow: the result of %d %s %d does not fit in a 64-bit integer", left, operator.String(), right)
+}
+
 func fmtRightOperandForIntArithmetic(right Value, operator parse.BinaryOperator) string {
 	return fmtRightOperandOfBinaryShouldBe(operator, "int", Stringify(right))
 }
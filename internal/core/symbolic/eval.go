@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"math"
 	"reflect"
 	"runtime/debug"
 	"strings"
@@ -117,16 +118,33 @@ type EvalCheckInput struct {
 	//nil if no project
 	ProjectFilesystem billy.Filesystem
 
+	//if true the warnings are included in the returned error and the error is not nil if there are any warnings.
+	WarningsAsErrors bool
+
+	//if true a warning is added for each captured local that is never read in the body of the function capturing it.
+	CheckUnusedCapturedLocals bool
+
+	//data returned by a previous check of the module (optional), the data of imported modules
+	//that have not changed since the previous check is reused instead of re-checking the modules.
+	PreviousData *Data
+
 	importPositions     []parse.SourcePositionRange
 	initialSymbolicData *Data
 }
 
 // EvalCheck performs various checks on an AST, most checks are type checks.
-// If the returned data is not nil the error is nil or is the combination of checking errors, the list of checking errors
-// is stored in the symbolic data.
+// If the returned data is not nil the error is nil or is the combination of checking errors (and warnings if .WarningsAsErrors is true),
+// the list of checking errors is stored in the symbolic data.
 // If the returned data is nil the error is an unexpected one (it is not about bad code).
 // StaticCheck() should be runned before this function.
 func EvalCheck(input EvalCheckInput) (*Data, error) {
+	data, _, err := evalCheck(input)
+	return data, err
+}
+
+// evalCheck is like EvalCheck but it also returns the result of the module's evaluation, the result is nil
+// if the module does not return any value or if the returned data is nil.
+func evalCheck(input EvalCheckInput) (_ *Data, result Value, _ error) {
 
 	state := newSymbolicState(input.Context, input.Module.mainChunk)
 	state.Module = input.Module
@@ -136,14 +154,16 @@ func EvalCheck(input EvalCheckInput) (*Data, error) {
 	state.importPositions = slices.Clone(input.importPositions)
 	state.shellTrustedCommands = input.ShellTrustedCommands
 	state.projectFilesystem = input.ProjectFilesystem
+	state.previousSymbolicData = input.PreviousData
+	state.checkUnusedCapturedLocals = input.CheckUnusedCapturedLocals
 
 	startingConcreteContext := input.Context.startingConcreteContext
 	if input.UseBaseGlobals {
 		if input.Globals != nil {
-			return nil, errors.New(".Globals should not be set")
+			return nil, nil, errors.New(".Globals should not be set")
 		}
 		if input.AdditionalSymbolicGlobalConsts != nil {
-			return nil, errors.New(".AdditionalSymbolicGlobalConsts should not be set")
+			return nil, nil, errors.New(".AdditionalSymbolicGlobalConsts should not be set")
 		}
 		for k, v := range input.SymbolicBaseGlobals {
 			state.setGlobal(k, v, GlobalConst)
@@ -152,7 +172,7 @@ func EvalCheck(input EvalCheckInput) (*Data, error) {
 		for k, concreteGlobal := range input.Globals {
 			symbolicVal, err := extData.ToSymbolicValue(startingConcreteContext, concreteGlobal.Value, false)
 			if err != nil {
-				return nil, fmt.Errorf("cannot convert global %s: %s", k, err)
+				return nil, nil, fmt.Errorf("cannot convert global %s: %s", k, err)
 			}
 			state.setGlobal(k, symbolicVal, concreteGlobal.Constness())
 		}
@@ -171,7 +191,7 @@ func EvalCheck(input EvalCheckInput) (*Data, error) {
 		for k, v := range input.ShellLocalVars {
 			symbolicVal, err := extData.ToSymbolicValue(startingConcreteContext, v, false)
 			if err != nil {
-				return nil, fmt.Errorf("cannot convert global %s: %s", k, err)
+				return nil, nil, fmt.Errorf("cannot convert global %s: %s", k, err)
 			}
 			state.setLocal(k, symbolicVal, &AnyPattern{})
 		}
@@ -183,15 +203,20 @@ func EvalCheck(input EvalCheckInput) (*Data, error) {
 		state.symbolicData = NewSymbolicData()
 	}
 
-	_, err := symbolicEval(input.Node, state)
+	result, err := symbolicEval(input.Node, state)
 
 	finalErrBuff := bytes.NewBuffer(nil)
 	if err != nil { //unexpected error
-		return nil, err
+		return nil, nil, err
 	}
 
-	if len(state.errors()) == 0 { //no error in checked code
-		return state.symbolicData, nil
+	var warnings []SymbolicEvaluationWarning
+	if input.WarningsAsErrors {
+		warnings = state.warnings()
+	}
+
+	if len(state.errors()) == 0 && len(warnings) == 0 { //no error in checked code
+		return state.symbolicData, result, nil
 	}
 
 	for _, err := range state.errors() {
@@ -199,7 +224,12 @@ func EvalCheck(input EvalCheckInput) (*Data, error) {
 		finalErrBuff.WriteRune('\n')
 	}
 
-	return state.symbolicData, errors.New(finalErrBuff.String())
+	for _, warning := range warnings {
+		finalErrBuff.WriteString(warning.LocatedMessage)
+		finalErrBuff.WriteRune('\n')
+	}
+
+	return state.symbolicData, result, errors.New(finalErrBuff.String())
 }
 
 func SymbolicEval(node parse.Node, state *State) (result Value, finalErr error) {
@@ -386,6 +416,13 @@ func _symbolicEval(node parse.Node, state *State, options evalOptions) (result V
 			return nil, err
 		}
 
+		pattern, ambiguousPatternNames := state.ctx.ResolveOptionPattern(n.Name)
+		if len(ambiguousPatternNames) > 0 {
+			state.addWarning(makeSymbolicEvalWarning(n, state, fmtSeveralPatternsDeclaredForOption(n.Name, ambiguousPatternNames)))
+		} else if pattern != nil && !pattern.pattern.TestValue(v, RecTestCallState{}) {
+			state.addError(makeSymbolicEvalError(n.Value, state, fmtOptionValueNotMatchingDeclaredPattern(n.Name, v, pattern.pattern)))
+		}
+
 		return NewOption(n.Name, v), nil
 	case *parse.AbsolutePathExpression, *parse.RelativePathExpression:
 		var slices []parse.Node
@@ -445,6 +482,7 @@ func _symbolicEval(node parse.Node, state *State, options evalOptions) (result V
 			state.addError(makeSymbolicEvalError(node, state, msg))
 			return ANY, nil
 		}
+		state.markLocalAsRead(n.Name)
 		return info.value, nil
 	case *parse.GlobalVariable:
 		return evalGlobalVariable(n, state, options)
@@ -519,6 +557,7 @@ func _symbolicEval(node parse.Node, state *State, options evalOptions) (result V
 	case *parse.SynchronizedBlockStatement:
 		return evalSynchronizedBlockStatement(n, state)
 	case *parse.PermissionDroppingStatement:
+		evalPermissionDroppingStatement(n, state)
 		return nil, nil
 	case *parse.InclusionImportStatement:
 		return evalInclusionImportStatement(n, state)
@@ -579,10 +618,16 @@ func _symbolicEval(node parse.Node, state *State, options evalOptions) (result V
 			return nil, err
 		}
 
-		switch upperBound.(type) {
+		switch upperBound := upperBound.(type) {
 		case *Int:
+			if upperBound.hasValue {
+				return NewUpperBoundIntRange(upperBound), nil
+			}
 			return ANY_INT_RANGE, nil
 		case *Float:
+			if upperBound.hasValue {
+				return NewUpperBoundFloatRange(upperBound, true), nil
+			}
 			return ANY_FLOAT_RANGE, nil
 		default:
 			return ANY_QUANTITY_RANGE, nil
@@ -605,6 +650,10 @@ func _symbolicEval(node parse.Node, state *State, options evalOptions) (result V
 			upperBound = NewInt(intLit.Value)
 		}
 
+		if stepLit, ok := n.Step.(*parse.IntLiteral); ok && stepLit.Err == nil && stepLit.Value > 0 {
+			return NewSteppedIntRange(lowerBound, upperBound, NewInt(stepLit.Value)), nil
+		}
+
 		return &IntRange{
 			hasValue: true,
 			start:    lowerBound,
@@ -684,7 +733,7 @@ func _symbolicEval(node parse.Node, state *State, options evalOptions) (result V
 	case *parse.FunctionPatternExpression:
 		return evalFunctionPatternExpression(n, state)
 	case *parse.PatternConversionExpression:
-		return evalPatternNode(n.Value, state)
+		return evalPatternNodeExt(n.Value, state, true)
 	case *parse.LazyExpression:
 		return &AstNode{Node: n}, nil
 	case *parse.MemberExpression:
@@ -787,15 +836,12 @@ func _symbolicEval(node parse.Node, state *State, options evalOptions) (result V
 
 		return ANY_BOOL, nil
 	case *parse.PatternIdentifierLiteral:
-		patt := state.ctx.ResolveNamedPattern(n.Name)
+		patt, suggestion := state.ctx.ResolveNamedPatternOrSuggest(n.Name)
 		if patt == nil {
-			names := state.ctx.AllNamedPatternNames()
-
 			var msg = ""
-			closestString, _, ok := utils.FindClosestString(state.ctx.startingConcreteContext, names, n.Name, 2)
 
-			if ok {
-				msg = fmtPatternIsNotDeclaredYouProbablyMeant(n.Name, closestString)
+			if suggestion != "" {
+				msg = fmtPatternIsNotDeclaredYouProbablyMeant(n.Name, suggestion)
 			} else {
 				msg = fmtPatternIsNotDeclared(n.Name)
 			}
@@ -1112,6 +1158,15 @@ func evalChunk(n *parse.Chunk, state *State) (_ Value, finalErr error) {
 	//If the chunk is the main one, recursively register all structs defined in the current module.
 	if n == state.Module.mainChunk.Node {
 		defineStructs(state.Module.mainChunk, n.Statements, state)
+
+		//imported modules share the symbolic data of the importing module.
+		isImportedModule := len(state.importPositions) > 0
+
+		defer func() {
+			if finalErr == nil && !isImportedModule {
+				state.symbolicData.SetTopLevelGlobalScopeData(state.currentGlobalScopeData())
+			}
+		}()
 	}
 
 	// Predeclare all Inox functions that don't capture locals.
@@ -1227,6 +1282,10 @@ func evalIdentifier(node *parse.IdentifierLiteral, state *State, evalOptions eva
 		return ANY, nil
 	}
 
+	if state.hasLocal(node.Name) {
+		state.markLocalAsRead(node.Name)
+	}
+
 	inoxFn, ok := info.value.(*inoxFunctionToBeDeclared)
 	if ok {
 		//Properly declare the function.
@@ -1328,6 +1387,23 @@ func evalPatternCallExpression(n *parse.PatternCallExpression, state *State) (_
 		args[i] = arg
 	}
 
+	//check the number of arguments if the arity of the pattern is known.
+	if typePattern, ok := callee.(*TypePattern); ok {
+		if arity, ok := typePattern.CallArity(); ok {
+			argCount := len(n.Arguments)
+
+			if argCount < arity.Min {
+				//the error is located on the closing parenthesis.
+				closingParenSpan := parse.NodeSpan{Start: n.Span.End - 1, End: n.Span.End}
+				state.addError(makeSymbolicEvalErrorWithSpan(closingParenSpan, state, fmtNotEnoughArgs(argCount, arity.Min)))
+			} else if arity.Max >= 0 && argCount > arity.Max {
+				for _, extraArg := range n.Arguments[arity.Max:] {
+					state.addError(makeSymbolicEvalError(extraArg, state, fmtTooManyArgs(argCount, arity.Max)))
+				}
+			}
+		}
+	}
+
 	if len(state.errors()) == errCount {
 		patt, err := callee.(Pattern).Call(state.ctx, args)
 		state.consumeSymbolicGoFunctionErrors(func(msg string) {
@@ -1820,6 +1896,86 @@ func evalAssignment(node *parse.Assignment, state *State) (_ Value, finalErr err
 			}
 		}
 
+	case *parse.ComputedMemberExpression:
+		object, err := _symbolicEval(lhs.Left, state, evalOptions{
+			doubleColonExprAncestorChain: []parse.Node{node},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		if node.Err != nil || lhs.PropertyName == nil {
+			return nil, nil
+		}
+
+		computedPropertyName, err := symbolicEval(lhs.PropertyName, state)
+		if err != nil {
+			return nil, err
+		}
+
+		//the property name is only known if the computed value is a concretizable string.
+		propName := ""
+		if str, ok := computedPropertyName.(StringLike); !ok {
+			state.addError(makeSymbolicEvalError(lhs.PropertyName, state, fmtComputedPropNameShouldBeAStringNotA(computedPropertyName)))
+		} else if s := str.GetOrBuildString(); s.hasValue {
+			propName = s.value
+		}
+
+		var iprops IProps
+		switch val := object.(type) {
+		case IProps:
+			iprops = val
+		case *Any:
+			return nil, nil //no check
+		default:
+			state.addError(makeSymbolicEvalError(node, state, FmtCannotAssignPropertyOf(val)))
+			return nil, nil
+		}
+
+		if propName == "" {
+			//the property is not known so the assigned value cannot be checked.
+			return nil, nil
+		}
+
+		var expectedValue Value
+		static, ok := iprops.(IToStatic)
+		if ok {
+			expectedIprops, ok := AsIprops(static.Static().SymbolicValue()).(IProps)
+			if ok && HasRequiredOrOptionalProperty(expectedIprops, propName) {
+				expectedValue = expectedIprops.Prop(propName)
+			}
+		}
+
+		rhs, deeperMismatch, err := getRHS(expectedValue)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, ok := iprops.(Serializable); ok {
+			if _, ok := rhs.(Serializable); !ok {
+				state.addError(makeSymbolicEvalError(node, state, INVALID_ASSIGN_NON_SERIALIZABLE_VALUE_NOT_ALLOWED_AS_PROPS_OF_SERIALIZABLE))
+				return nil, nil
+			}
+		}
+
+		if node.Operator.Int() {
+			if !utils.SliceContains(iprops.PropertyNames(), propName) {
+				break
+			}
+			widenedPrevValue := MergeValuesWithSameStaticTypeInMultivalue(iprops.Prop(propName))
+
+			if _, ok := widenedPrevValue.(*Int); !ok {
+				state.addError(makeSymbolicEvalError(node, state, INVALID_ASSIGN_INT_OPER_ASSIGN_LHS_NOT_INT))
+			}
+		} else if !badIntOperationRHS {
+			if newIprops, err := iprops.SetProp(propName, rhs); err != nil {
+				if !deeperMismatch {
+					state.addError(makeSymbolicEvalError(node, state, err.Error()))
+				}
+			} else {
+				narrowChain(lhs.Left, setExactValue, newIprops, state, 0)
+			}
+		}
 	case *parse.IdentifierMemberExpression:
 		v, err := _symbolicEval(lhs.Left, state, evalOptions{
 			doubleColonExprAncestorChain: []parse.Node{node},
@@ -2290,7 +2446,7 @@ func evalMultiAssignment(n *parse.MultiAssignment, state *State) (_ Value, final
 		return nil, err
 	}
 
-	seq, ok := right.(Sequence)
+	seq, ok := right.(Indexable)
 	if !ok {
 		state.addError(makeSymbolicEvalError(n, state, fmtSeqExpectedButIs(startRight)))
 		right = &List{generalElement: ANY_SERIALIZABLE}
@@ -2596,12 +2752,13 @@ func evalWalkStatement(n *parse.WalkStatement, state *State) (_ Value, finalErr
 }
 
 func evalSwitchStatement(n *parse.SwitchStatement, state *State) (_ Value, finalErr error) {
-	_, err := symbolicEval(n.Discriminant, state)
+	discriminant, err := symbolicEval(n.Discriminant, state)
 	if err != nil {
 		return nil, err
 	}
 
 	var forks []*State
+	var caseValues []Value
 
 	for _, switchCase := range n.Cases {
 		for _, valNode := range switchCase.Values {
@@ -2609,6 +2766,7 @@ func evalSwitchStatement(n *parse.SwitchStatement, state *State) (_ Value, final
 			if err != nil {
 				return nil, err
 			}
+			caseValues = append(caseValues, caseValue)
 
 			if switchCase.Block == nil {
 				continue
@@ -2638,11 +2796,43 @@ func evalSwitchStatement(n *parse.SwitchStatement, state *State) (_ Value, final
 		}
 	}
 
+	if len(n.DefaultCases) == 0 {
+		uncoveredValues := getValuesNotCoveredBySwitchCases(discriminant, caseValues)
+		if len(uncoveredValues) > 0 {
+			state.addWarning(makeSymbolicEvalWarning(n, state, fmtSwitchStatementDoesNotCoverValues(uncoveredValues)))
+		}
+	}
+
 	state.join(forks...)
 
 	return nil, nil
 }
 
+// getValuesNotCoveredBySwitchCases returns the possible values of $discriminant that are not equal to any case value,
+// nil is returned if $discriminant is not a finite union of concretizable values.
+func getValuesNotCoveredBySwitchCases(discriminant Value, caseValues []Value) (uncovered []Value) {
+	multivalue, ok := discriminant.(IMultivalue)
+	if !ok {
+		return nil
+	}
+
+	for _, possibleValue := range multivalue.OriginalMultivalue().getValues() {
+		if !IsConcretizable(possibleValue) {
+			return nil
+		}
+
+		covered := slices.ContainsFunc(caseValues, func(caseValue Value) bool {
+			return caseValue.Test(possibleValue, RecTestCallState{}) && possibleValue.Test(caseValue, RecTestCallState{})
+		})
+
+		if !covered {
+			uncovered = append(uncovered, possibleValue)
+		}
+	}
+
+	return
+}
+
 func evalMatchStatement(n *parse.MatchStatement, state *State) (_ Value, finalErr error) {
 	discriminant, err := symbolicEval(n.Discriminant, state)
 	if err != nil {
@@ -2705,7 +2895,13 @@ func evalMatchStatement(n *parse.MatchStatement, state *State) (_ Value, finalEr
 				if !ok {
 					state.addError(makeSymbolicEvalError(valNode, state, fmtXisNotAGroupMatchingPattern(pattern)))
 				} else {
-					ok, groups := groupPattern.MatchGroups(discriminant)
+					//The groups are computed from the narrowed value of the discriminant.
+					narrowedDiscriminant := discriminant
+					if !pattern.TestValue(discriminant, RecTestCallState{}) {
+						narrowedDiscriminant = patternMatchingValue
+					}
+
+					ok, groups := groupPattern.MatchGroups(narrowedDiscriminant)
 					if ok {
 						groupsObj := NewInexactObject(groups, nil, nil)
 						blockStateFork.setLocal(variable.Name, groupsObj, nil, matchCase.GroupMatchingVariable)
@@ -2820,26 +3016,27 @@ func evalBinaryExpression(n *parse.BinaryExpression, state *State, options evalO
 		return ANY, nil
 	case parse.Equal, parse.NotEqual, parse.Is, parse.IsNot:
 		return ANY_BOOL, nil
-	case parse.In:
-		switch right.(type) {
-		case Container:
-		default:
+	case parse.In, parse.NotIn:
+		container, isContainer := right.(Container)
+		if !isContainer {
 			state.addError(makeSymbolicEvalError(n.Right, state, fmtRightOperandOfBinaryShouldBe(n.Operator, "container", Stringify(right))))
 		}
-		_, ok := AsSerializable(left).(Serializable)
-		if !ok {
+		serializable, isSerializable := AsSerializable(left).(Serializable)
+		if !isSerializable {
 			state.addError(makeSymbolicEvalError(n.Left, state, fmtLeftOperandOfBinaryShouldBe(n.Operator, "serializable", Stringify(left))))
 		}
-		return ANY_BOOL, nil
-	case parse.NotIn:
+
+		//if the value and the immutable container are fully known the result is known.
 		switch right.(type) {
-		case Container:
+		case *Tuple, *Record:
 		default:
-			state.addError(makeSymbolicEvalError(n.Right, state, fmtRightOperandOfBinaryShouldBe(n.Operator, "container", Stringify(right))))
+			isContainer = false
 		}
-		_, ok := AsSerializable(left).(Serializable)
-		if !ok {
-			state.addError(makeSymbolicEvalError(n.Left, state, fmtLeftOperandOfBinaryShouldBe(n.Operator, "serializable", Stringify(left))))
+		if isContainer && isSerializable && IsConcretizable(left) && IsConcretizable(right) {
+			yes, possible := container.Contains(serializable)
+			if yes || !possible {
+				return NewBool(yes == (n.Operator == parse.In)), nil
+			}
 		}
 		return ANY_BOOL, nil
 	case parse.Keyof:
@@ -2937,6 +3134,26 @@ func evalBinaryExpression(n *parse.BinaryExpression, state *State, options evalO
 		}
 
 		return ANY_BOOL, nil
+	case parse.As:
+		pattern, ok := right.(Pattern)
+		if !ok {
+			state.addError(makeSymbolicEvalError(n.Right, state, fmtRightOperandOfBinaryShouldBe(n.Operator, "pattern", Stringify(right))))
+			return ANY, nil
+		}
+
+		target := pattern.SymbolicValue()
+
+		if !HaveIntersection(left, target) {
+			state.addError(makeSymbolicEvalError(n, state, fmtValueCannotBeConvertedTo(left, pattern)))
+			return target, nil
+		}
+
+		//if the conversion succeeds at runtime the left operand necessarily has the target type.
+		if _, ok := left.(IMultivalue); ok {
+			narrowChain(n.Left, setExactValue, target, state, 0)
+		}
+
+		return target, nil
 	case parse.Substrof:
 
 		switch left.(type) {
@@ -2955,6 +3172,19 @@ func evalBinaryExpression(n *parse.BinaryExpression, state *State, options evalO
 			}
 		}
 
+		//the result is known if both operands are known strings.
+		leftStrLike, ok1 := left.(StringLike)
+		rightStrLike, ok2 := right.(StringLike)
+
+		if ok1 && ok2 {
+			leftStr := leftStrLike.GetOrBuildString()
+			rightStr := rightStrLike.GetOrBuildString()
+
+			if leftStr.hasValue && rightStr.hasValue {
+				return NewBool(strings.Contains(rightStr.value, leftStr.value)), nil
+			}
+		}
+
 		return ANY_BOOL, nil
 	case parse.SetDifference:
 		if _, ok := left.(Pattern); !ok {
@@ -2965,7 +3195,14 @@ func evalBinaryExpression(n *parse.BinaryExpression, state *State, options evalO
 			Removed: ANY_PATTERN,
 		}, nil
 	case parse.NilCoalescing:
-		return joinValues([]Value{narrowOut(Nil, left), right}), nil
+		nonNilLeft := narrowOut(Nil, left)
+		if nonNilLeft == NEVER { //left is always nil
+			return right, nil
+		}
+		if !left.Test(Nil, RecTestCallState{}) { //left is never nil
+			return left, nil
+		}
+		return joinValues([]Value{nonNilLeft, right}), nil
 	case parse.PairComma:
 		leftSerializable, ok := AsSerializable(left).(Serializable)
 		if !ok {
@@ -2991,18 +3228,47 @@ func evalBinaryExpression(n *parse.BinaryExpression, state *State, options evalO
 	}
 }
 
-// +, -, *, /
+// intArithmeticOverflows returns true if the result of the operation (+, -, *, /) on l and r does not fit in an int64.
+func intArithmeticOverflows(operator parse.BinaryOperator, l, r int64) bool {
+	switch operator {
+	case parse.Add:
+		return (r > 0 && l > math.MaxInt64-r) || (r < 0 && l < math.MinInt64-r)
+	case parse.Sub:
+		return (r < 0 && l > math.MaxInt64+r) || (r > 0 && l < math.MinInt64+r)
+	case parse.Mul:
+		if l == 0 || r == 0 {
+			return false
+		}
+		if (l == -1 && r == math.MinInt64) || (r == -1 && l == math.MinInt64) {
+			return true
+		}
+		product := l * r
+		return product/r != l
+	case parse.Div:
+		return l == math.MinInt64 && r == -1
+	}
+	return false
+}
+
+// evalArithmeticBinaryExpression checks an arithmetic binary expression (+, -, *, /) and returns its result.
+// There is no implicit numeric promotion: at runtime (tree walk evaluation and OpNumBin) both operands of an
+// integer or float operation are required to have the same type, so mixing integers and floats is an error.
 func evalArithmeticBinaryExpression(left, right Value, n *parse.BinaryExpression, state *State) (Value, error) {
-	if _, ok := left.(*Int); ok {
-		_, ok = right.(*Int)
-		if !ok {
+	if ImplementsOrIsMultivalueWithAllValuesImplementing[*Int](left) {
+		if !ImplementsOrIsMultivalueWithAllValuesImplementing[*Int](right) {
 			state.addError(makeSymbolicEvalError(n.Right, state, fmtRightOperandForIntArithmetic(right, n.Operator)))
+		} else {
+			leftInt, ok1 := left.(*Int)
+			rightInt, ok2 := right.(*Int)
+
+			if ok1 && ok2 && leftInt.hasValue && rightInt.hasValue && intArithmeticOverflows(n.Operator, leftInt.value, rightInt.value) {
+				state.addWarning(makeSymbolicEvalWarning(n, state, fmtIntArithmeticOverflows(leftInt.value, n.Operator, rightInt.value)))
+			}
 		}
 
 		return ANY_INT, nil
-	} else if _, ok := left.(*Float); ok {
-		_, ok = right.(*Float)
-		if !ok {
+	} else if ImplementsOrIsMultivalueWithAllValuesImplementing[*Float](left) {
+		if !ImplementsOrIsMultivalueWithAllValuesImplementing[*Float](right) {
 			state.addError(makeSymbolicEvalError(n.Right, state, fmtRightOperandForFloatArithmetic(right, n.Operator)))
 		}
 		return ANY_FLOAT, nil
@@ -3123,10 +3389,17 @@ func evalFunctionExpression(n *parse.FunctionExpression, state *State, options e
 
 	//declare captured locals
 	capturedLocals := map[string]Value{}
+	stateFork.readLocals = nil
+	if state.checkUnusedCapturedLocals && len(n.CaptureList) > 0 {
+		//reads of the captured locals in the body are tracked by the fork.
+		stateFork.readLocals = map[string]struct{}{}
+	}
+
 	for _, e := range n.CaptureList {
 		name := e.(*parse.IdentifierLiteral).Name
 		info, ok := state.getLocal(name)
 		if ok {
+			state.markLocalAsRead(name)
 			stateFork.setLocal(name, info.value, info.static, e)
 			capturedLocals[name] = info.value
 		} else {
@@ -3190,7 +3463,11 @@ func evalFunctionExpression(n *parse.FunctionExpression, state *State, options e
 		//check return
 
 		if signatureReturnType != nil {
-			if !signatureReturnType.Test(storedReturnType, RecTestCallState{}) {
+			_, isNil := storedReturnType.(*NilT)
+
+			if isNil && !signatureReturnType.Test(Nil, RecTestCallState{}) {
+				state.addError(makeSymbolicEvalError(n.Body, state, fmtBodyExpressionOfFunctionEvaluatesToNil(signatureReturnType)))
+			} else if !signatureReturnType.Test(storedReturnType, RecTestCallState{}) {
 				state.addError(makeSymbolicEvalError(n.Body, state, fmtInvalidReturnValue(storedReturnType, signatureReturnType)))
 			}
 			storedReturnType = signatureReturnType
@@ -3224,6 +3501,17 @@ func evalFunctionExpression(n *parse.FunctionExpression, state *State, options e
 
 	state.popCall()
 
+	//check that the captured locals are used.
+
+	if stateFork.readLocals != nil {
+		for _, e := range n.CaptureList {
+			name := e.(*parse.IdentifierLiteral).Name
+			if _, ok := stateFork.readLocals[name]; !ok {
+				state.addWarning(makeSymbolicEvalWarning(e, state, fmtCapturedLocalIsNeverRead(name)))
+			}
+		}
+	}
+
 	//check that the body does not contain forbidden node types.
 
 	if expectedFunction, ok := findInMultivalue[*InoxFunction](options.expectedValue); ok && expectedFunction.visitCheckNode != nil {
@@ -3406,6 +3694,8 @@ func evalFunctionPatternExpression(n *parse.FunctionPatternExpression, state *St
 }
 
 func evalSynchronizedBlockStatement(n *parse.SynchronizedBlockStatement, state *State) (_ Value, finalErr error) {
+	var nonSharableValues []Value
+
 	for _, valNode := range n.SynchronizedValues {
 		val, err := symbolicEval(valNode, state)
 		if err != nil {
@@ -3417,10 +3707,14 @@ func evalSynchronizedBlockStatement(n *parse.SynchronizedBlockStatement, state *
 		}
 
 		if potentiallySharable, ok := val.(PotentiallySharable); !ok || !utils.Ret0(potentiallySharable.IsSharable()) {
-			state.addError(makeSymbolicEvalError(n, state, fmtSynchronizedValueShouldBeASharableValueOrImmutableNot(val)))
+			nonSharableValues = append(nonSharableValues, val)
 		}
 	}
 
+	if len(nonSharableValues) > 0 {
+		state.addError(makeSymbolicEvalError(n, state, fmtSynchronizedValuesShouldBeSharableOrImmutable(nonSharableValues)))
+	}
+
 	if n.Block == nil {
 		return nil, nil
 	}
@@ -3451,12 +3745,55 @@ func evalInclusionImportStatement(n *parse.InclusionImportStatement, state *Stat
 	return nil, err
 }
 
+func evalPermissionDroppingStatement(n *parse.PermissionDroppingStatement, state *State) {
+	if n.Object == nil {
+		return
+	}
+
+	for _, kindSection := range n.Object.Properties {
+		if kindSection.HasImplicitKey() {
+			continue
+		}
+
+		kind, ok := permkind.PermissionKindFromString(kindSection.Name())
+		if !ok {
+			continue
+		}
+
+		typeSections, ok := kindSection.Value.(*parse.ObjectLiteral)
+		if !ok {
+			continue
+		}
+
+		//only the permissions without a specific resource are tracked.
+		for _, typeSection := range typeSections.Properties {
+			if typeSection.HasImplicitKey() {
+				continue
+			}
+
+			switch typeSection.Name() {
+			case "threads":
+				state.ctx.DropPermissions(kind, permkind.LTHREAD_PERM_TYPENAME)
+			case "system-graph":
+				state.ctx.DropPermissions(kind, permkind.SYSGRAPH_PERM_TYPENAME)
+			}
+		}
+	}
+}
+
 func evalImportStatement(n *parse.ImportStatement, state *State) (_ Value, finalErr error) {
-	value := ANY
-	state.setGlobal(n.Identifier.Name, value, GlobalConst)
+	//the value of the import identifier is the result of the imported module if it has been successfully checked.
+	var value Value = ANY
 
-	state.symbolicData.SetMostSpecificNodeValue(n.Identifier, value)
-	state.symbolicData.SetGlobalScopeData(n, state.currentGlobalScopeData())
+	defer func() {
+		if finalErr != nil {
+			return
+		}
+		state.setGlobal(n.Identifier.Name, value, GlobalConst)
+
+		state.symbolicData.SetMostSpecificNodeValue(n.Identifier, value)
+		state.symbolicData.SetGlobalScopeData(n, state.currentGlobalScopeData())
+	}()
 
 	var pathOrURL string
 
@@ -3492,8 +3829,21 @@ func evalImportStatement(n *parse.ImportStatement, state *State) (_ Value, final
 	}
 
 	importPositions := append(slices.Clone(state.importPositions), state.getErrorMesssageLocation(n)...)
+	importedModuleChunks := importedModule.chunks()
+
+	//reuse the data of the previous check if the imported module has not changed.
+	if state.previousSymbolicData != nil {
+		check, ok := state.previousSymbolicData.importedModuleChecks[importedModule.mainChunk.Node]
+		if ok && check.isReusable(importPositions, importedModuleChunks) {
+			value = check.result
+			return nil, state.symbolicData.reuseImportedModuleCheck(state.previousSymbolicData, check)
+		}
+	}
 
-	data, err := EvalCheck(EvalCheckInput{
+	errorCountBeforeCheck := len(state.symbolicData.errors)
+	warningCountBeforeCheck := len(state.symbolicData.warnings)
+
+	data, result, err := evalCheck(EvalCheckInput{
 		Node:   importedModule.mainChunk.Node,
 		Module: importedModule,
 
@@ -3507,12 +3857,26 @@ func evalImportStatement(n *parse.ImportStatement, state *State) (_ Value, final
 		importPositions:     importPositions,
 
 		ProjectFilesystem: state.projectFilesystem,
+		PreviousData:      state.previousSymbolicData,
 	})
 
 	if data == nil && err != nil {
 		return nil, err
 	}
 
+	//the result is only used if there are no errors because a module with errors cannot be executed.
+	if result != nil && len(state.symbolicData.errors) == errorCountBeforeCheck {
+		value = result
+	}
+
+	state.symbolicData.importedModuleChecks[importedModule.mainChunk.Node] = &importedModuleCheck{
+		importPositions: importPositions,
+		chunks:          importedModuleChunks,
+		errors:          slices.Clone(state.symbolicData.errors[errorCountBeforeCheck:]),
+		warnings:        slices.Clone(state.symbolicData.warnings[warningCountBeforeCheck:]),
+		result:          value,
+	}
+
 	return nil, nil
 }
 
@@ -3522,7 +3886,7 @@ func evalSpawnExpression(node *parse.SpawnExpression, state *State) (_ Value, fi
 
 	var meta map[string]Value
 	var globals any
-	var permListingNode *parse.ObjectLiteral
+	var globalsKeyListNode *parse.KeyListExpression
 
 	//check permissions
 	if !state.ctx.HasAPermissionWithKindAndType(permkind.Create, permkind.LTHREAD_PERM_TYPENAME) {
@@ -3565,8 +3929,7 @@ func evalSpawnExpression(node *parse.SpawnExpression, state *State) (_ Value, fi
 					}
 					continue
 				}
-			} else if sectionName == LTHREAD_META_ALLOW_SECTION && utils.Implements[*parse.ObjectLiteral](sectionProp.Value) {
-				permListingNode = sectionProp.Value.(*parse.ObjectLiteral)
+				globalsKeyListNode, _ = sectionProp.Value.(*parse.KeyListExpression)
 			}
 
 			propertyVal, err := symbolicEval(sectionProp.Value, state)
@@ -3612,12 +3975,15 @@ func evalSpawnExpression(node *parse.SpawnExpression, state *State) (_ Value, fi
 			actualGlobals[k] = symVal
 		}
 	case *KeyList:
-		for _, name := range g.Keys {
+		for i, name := range g.Keys {
 			info, ok := state.getGlobal(name)
 			if ok {
 				actualGlobals[name] = info.value
 			} else {
 				actualGlobals[name] = ANY
+				if globalsKeyListNode != nil && i < len(globalsKeyListNode.Keys) {
+					state.addWarning(makeSymbolicEvalWarning(globalsKeyListNode.Keys[i], state, fmtGlobalPassedToLThreadIsNotDefined(name)))
+				}
 			}
 		}
 	case nil, *NilT:
@@ -3644,17 +4010,16 @@ func evalSpawnExpression(node *parse.SpawnExpression, state *State) (_ Value, fi
 	}
 
 	var concreteCtx ConcreteContext = state.ctx.startingConcreteContext
-	if permListingNode != nil && extData.EstimatePermissionsFromListingNode != nil {
-		perms, err := extData.EstimatePermissionsFromListingNode(permListingNode)
+	if extData.EstimateLThreadPermissions != nil {
+		perms, err := extData.EstimateLThreadPermissions(node, state.ctx.isolatedConcreteContext)
 		if err != nil {
 			return nil, fmt.Errorf("failed to estimate permission of spawned lthread: %w", err)
 		}
-		concreteCtx = extData.CreateConcreteContext(perms)
+		if perms != nil {
+			concreteCtx = extData.CreateConcreteContext(perms)
+		}
 	}
 
-	_ = permListingNode
-
-	//TODO: check the allow section to know the permissions
 	modCtx := NewSymbolicContext(state.ctx.startingConcreteContext, concreteCtx, state.ctx)
 	modState := newSymbolicState(modCtx, &parse.ParsedChunkSource{
 		Node:   embeddedModule,
@@ -3718,16 +4083,29 @@ func evalSpawnExpression(node *parse.SpawnExpression, state *State) (_ Value, fi
 func evalMappingExpression(n *parse.MappingExpression, state *State) (_ Value, finalErr error) {
 	mapping := &Mapping{}
 
+	var keyTypes []Value
+	var valueTypes []Value
+
 	for _, entry := range n.Entries {
 		fork := state.fork()
 		fork.pushScope()
 
 		switch e := entry.(type) {
 		case *parse.StaticMappingEntry:
-			_, err := symbolicEval(e.Value, fork)
+			key, err := symbolicEval(e.Key, fork)
+			if err != nil {
+				return nil, err
+			}
+			if patt, ok := key.(Pattern); ok {
+				key = patt.SymbolicValue()
+			}
+			keyTypes = append(keyTypes, key)
+
+			value, err := symbolicEval(e.Value, fork)
 			if err != nil {
 				return nil, err
 			}
+			valueTypes = append(valueTypes, value)
 		case *parse.DynamicMappingEntry:
 			key, err := symbolicEval(e.Key, fork)
 			if err != nil {
@@ -3741,6 +4119,7 @@ func evalMappingExpression(n *parse.MappingExpression, state *State) (_ Value, f
 			}
 			fork.setLocal(keyVarname, keyVal, nil, e.KeyVar)
 			state.symbolicData.SetMostSpecificNodeValue(e.KeyVar, keyVal)
+			keyTypes = append(keyTypes, keyVal)
 
 			if e.GroupMatchingVariable != nil {
 				matchingVarName := e.GroupMatchingVariable.(*parse.IdentifierLiteral).Name
@@ -3749,58 +4128,68 @@ func evalMappingExpression(n *parse.MappingExpression, state *State) (_ Value, f
 				state.symbolicData.SetMostSpecificNodeValue(e.GroupMatchingVariable, anyObj)
 			}
 
-			_, err = symbolicEval(e.ValueComputation, fork)
+			value, err := symbolicEval(e.ValueComputation, fork)
 			if err != nil {
 				return nil, err
 			}
+			valueTypes = append(valueTypes, value)
 		}
 	}
 
+	if len(keyTypes) > 0 {
+		mapping.keyType = joinValues(keyTypes)
+		mapping.valueType = joinValues(valueTypes)
+	}
+
 	return mapping, nil
 }
 
 func evalTreedataLiteral(n *parse.TreedataLiteral, state *State, options evalOptions) (Value, error) {
-
-	value, err := symbolicEval(n.Root, state)
+	nodeValues, err := evalTreedataNode(n.Root, n.Children, state)
 	if err != nil {
 		return nil, err
 	}
 
-	if value.IsMutable() {
-		state.addError(makeSymbolicEvalError(n.Root, state, VALUES_INSIDE_A_TREEDATA_SHOULD_BE_IMMUTABLE))
-	} else if _, ok := AsSerializable(value).(Serializable); !ok {
-		state.addError(makeSymbolicEvalError(n.Root, state, VALUES_INSIDE_A_TREEDATA_SHOULD_BE_SERIALIZABLE))
-	}
+	return &Treedata{nodeValue: joinValues(nodeValues)}, nil
+}
 
-	for _, child := range n.Children {
-		_, err := symbolicEval(child, state)
-		if err != nil {
-			return nil, err
-		}
+func evalTreedataEntry(n *parse.TreedataEntry, state *State, options evalOptions) (Value, error) {
+	nodeValues, err := evalTreedataNode(n.Value, n.Children, state)
+	if err != nil {
+		return nil, err
 	}
-	return &Treedata{}, nil
+
+	return &TreedataHiearchyEntry{nodeValue: joinValues(nodeValues)}, nil
 }
 
-func evalTreedataEntry(n *parse.TreedataEntry, state *State, options evalOptions) (Value, error) {
-	value, err := symbolicEval(n.Value, state)
+// evalTreedataNode evaluates the value and the child entries of a treedata node (root or entry),
+// it returns the values of the node and of all its descendants.
+func evalTreedataNode(valueNode parse.Node, children []*parse.TreedataEntry, state *State) ([]Value, error) {
+	value, err := symbolicEval(valueNode, state)
 	if err != nil {
 		return nil, err
 	}
 
+	var nodeValue Value = ANY_SERIALIZABLE
+
 	if value.IsMutable() {
-		state.addError(makeSymbolicEvalError(n.Value, state, VALUES_INSIDE_A_TREEDATA_SHOULD_BE_IMMUTABLE))
-	} else if _, ok := AsSerializable(value).(Serializable); !ok {
-		state.addError(makeSymbolicEvalError(n.Value, state, VALUES_INSIDE_A_TREEDATA_SHOULD_BE_SERIALIZABLE))
+		state.addError(makeSymbolicEvalError(valueNode, state, VALUES_INSIDE_A_TREEDATA_SHOULD_BE_IMMUTABLE))
+	} else if serializable, ok := AsSerializable(value).(Serializable); ok {
+		nodeValue = serializable
+	} else {
+		state.addError(makeSymbolicEvalError(valueNode, state, VALUES_INSIDE_A_TREEDATA_SHOULD_BE_SERIALIZABLE))
 	}
 
-	for _, child := range n.Children {
-		_, err := symbolicEval(child, state)
+	nodeValues := []Value{nodeValue}
+
+	for _, child := range children {
+		childEntry, err := symbolicEval(child, state)
 		if err != nil {
 			return nil, err
 		}
+		nodeValues = append(nodeValues, childEntry.(*TreedataHiearchyEntry).nodeValue)
 	}
-
-	return &TreedataHiearchyEntry{}, nil
+	return nodeValues, nil
 }
 
 func evalTreedataPair(n *parse.TreedataPair, state *State, options evalOptions) (Value, error) {
@@ -4317,6 +4706,14 @@ func evalListLiteral(n *parse.ListLiteral, state *State, options evalOptions) (V
 	}
 
 	if len(n.Elements) == 0 {
+		//if a list of a given type is expected the empty list adopts the element type
+		//so that the elements added later (e.g. by calling .append) are checked.
+		if expectedList != nil && expectedList.generalElement != nil {
+			list := NewEmptyListOf(expectedList.generalElement)
+			list.readonly = expectedList.readonly
+			return list, nil
+		}
+
 		if expectedList != nil && expectedList.readonly {
 			return EMPTY_READONLY_LIST, nil
 		}
@@ -4601,24 +4998,11 @@ func evalObjectPatternLiteral(n *parse.ObjectPatternLiteral, state *State, optio
 			return nil, err
 		}
 
-		if objPattern, ok := compiledElement.(*ObjectPattern); ok {
-			if objPattern.entries == nil {
-				state.addError(makeSymbolicEvalError(el, state, CANNOT_SPREAD_OBJ_PATTERN_THAT_MATCHES_ANY_OBJECT))
-			} else {
-				for name, vpattern := range objPattern.entries {
-					if _, alreadyPresent := pattern.entries[name]; alreadyPresent {
-						state.addError(makeSymbolicEvalError(el, state, fmtPropertyShouldNotBePresentInSeveralSpreadPatterns(name)))
-						continue
-					}
-					pattern.entries[name] = vpattern
-				}
-			}
-			// else if objPattern.Inexact {
-			// state.addError(makeSymbolicEvalError(el, state, CANNOT_SPREAD_OBJ_PATTERN_THAT_IS_INEXACT))
-			//
-
-		} else {
-			state.addError(makeSymbolicEvalError(el, state, fmtPatternSpreadInObjectPatternShouldBeAnObjectPatternNot(compiledElement)))
+		switch compiledElement.(type) {
+		case *ObjectPattern, *RecordPattern:
+			addSpreadPatternEntries(el, compiledElement, state, pattern.entries, &pattern.optionalEntries, &pattern.inexact, false)
+		default:
+			state.addError(makeSymbolicEvalError(el, state, fmtPatternSpreadInObjectPatternShouldBeAnObjectOrRecordPatternNot(compiledElement)))
 		}
 	}
 
@@ -4682,20 +5066,11 @@ func evalRecordPatternLiteral(n *parse.RecordPatternLiteral, state *State, optio
 			return nil, err
 		}
 
-		if recPattern, ok := compiledElement.(*RecordPattern); ok {
-			if recPattern.entries == nil {
-				state.addError(makeSymbolicEvalError(el, state, CANNOT_SPREAD_REC_PATTERN_THAT_MATCHES_ANY_RECORD))
-			} else {
-				for name, vpattern := range recPattern.entries {
-					if _, alreadyPresent := pattern.entries[name]; alreadyPresent {
-						state.addError(makeSymbolicEvalError(el, state, fmtPropertyShouldNotBePresentInSeveralSpreadPatterns(name)))
-						continue
-					}
-					pattern.entries[name] = vpattern
-				}
-			}
-		} else {
-			state.addError(makeSymbolicEvalError(el, state, fmtPatternSpreadInRecordPatternShouldBeAnRecordPatternNot(compiledElement)))
+		switch compiledElement.(type) {
+		case *RecordPattern, *ObjectPattern:
+			addSpreadPatternEntries(el, compiledElement, state, pattern.entries, &pattern.optionalEntries, &pattern.inexact, true)
+		default:
+			state.addError(makeSymbolicEvalError(el, state, fmtPatternSpreadInRecordPatternShouldBeARecordOrObjectPatternNot(compiledElement)))
 		}
 	}
 
@@ -4756,6 +5131,66 @@ func evalRecordPatternLiteral(n *parse.RecordPatternLiteral, state *State, optio
 	return pattern, nil
 }
 
+// addSpreadPatternEntries adds the entries of a spread object or record pattern to the entries of the object or record
+// pattern being evaluated, the optional entries and the inexactness of the spread pattern are carried. If onlyImmutable
+// is true the entries matching mutable values are not added (record pattern).
+func addSpreadPatternEntries(
+	spreadElem parse.Node, spread Pattern, state *State,
+	entries map[string]Pattern, optionalEntries *map[string]struct{}, inexact *bool, onlyImmutable bool,
+) {
+	var (
+		spreadEntries         map[string]Pattern
+		spreadOptionalEntries map[string]struct{}
+		spreadInexact         bool
+		inexactWarning        string
+	)
+
+	switch spread := spread.(type) {
+	case *ObjectPattern:
+		if spread.entries == nil {
+			state.addError(makeSymbolicEvalError(spreadElem, state, CANNOT_SPREAD_OBJ_PATTERN_THAT_MATCHES_ANY_OBJECT))
+			return
+		}
+		spreadEntries, spreadOptionalEntries, spreadInexact = spread.entries, spread.optionalEntries, spread.inexact
+		inexactWarning = SPREAD_INEXACT_OBJ_PATTERN_MAKES_RESULT_INEXACT
+	case *RecordPattern:
+		if spread.entries == nil {
+			state.addError(makeSymbolicEvalError(spreadElem, state, CANNOT_SPREAD_REC_PATTERN_THAT_MATCHES_ANY_RECORD))
+			return
+		}
+		spreadEntries, spreadOptionalEntries, spreadInexact = spread.entries, spread.optionalEntries, spread.inexact
+		inexactWarning = SPREAD_INEXACT_REC_PATTERN_MAKES_RESULT_INEXACT
+	default:
+		panic(ErrUnreachable)
+	}
+
+	for name, vpattern := range spreadEntries {
+		if _, alreadyPresent := entries[name]; alreadyPresent {
+			state.addError(makeSymbolicEvalError(spreadElem, state, fmtPropertyShouldNotBePresentInSeveralSpreadPatterns(name)))
+			continue
+		}
+		if onlyImmutable && vpattern.SymbolicValue().IsMutable() {
+			state.addError(makeSymbolicEvalError(spreadElem, state, fmtEntryOfObjectPatternSpreadInRecordPatternShouldMatchOnlyImmutableValues(name)))
+			continue
+		}
+		entries[name] = vpattern
+
+		if _, isOptional := spreadOptionalEntries[name]; isOptional {
+			if *optionalEntries == nil {
+				*optionalEntries = make(map[string]struct{}, 1)
+			}
+			(*optionalEntries)[name] = struct{}{}
+		}
+	}
+
+	//the properties not specified by an inexact pattern can have any value,
+	//so the resulting pattern is inexact too.
+	if spreadInexact && !*inexact {
+		*inexact = true
+		state.addWarning(makeSymbolicEvalWarning(spreadElem, state, inexactWarning))
+	}
+}
+
 func evalListPatternLiteral(n *parse.ListPatternLiteral, state *State, options evalOptions) (Value, error) {
 	pattern := &ListPattern{}
 
@@ -4887,7 +5322,7 @@ func evalConcatenationExpression(n *parse.ConcatenationExpression, state *State,
 			}
 
 			switch iterableElemVal.(type) {
-			case StringLike, BytesLike, *Tuple:
+			case StringLike, BytesLike, *Tuple, *List:
 				values = append(values, iterableElemVal)
 				nodeIndexes = append(nodeIndexes, elemNodeIndex)
 			default:
@@ -4950,6 +5385,46 @@ func evalConcatenationExpression(n *parse.ConcatenationExpression, state *State,
 		} else {
 			return NewTuple(elements...), nil
 		}
+	case *List:
+		var generalElements []Value
+		var elements []Serializable
+		hasUnknownLen := false
+
+		for i, concatElem := range values {
+			elemNode := n.Elements[nodeIndexes[i]]
+
+			list, ok := concatElem.(*List)
+			if !ok {
+				state.addError(makeSymbolicEvalError(elemNode, state, fmt.Sprintf("list concatenation: invalid element of type %T", concatElem)))
+				continue
+			}
+
+			var listElements []Serializable
+
+			if list.HasKnownLen() {
+				listElements = list.elements
+				elements = append(elements, listElements...)
+			} else {
+				hasUnknownLen = true
+				listElements = []Serializable{list.generalElement}
+				generalElements = append(generalElements, list.generalElement)
+			}
+
+			for _, e := range listElements {
+				if _, ok := asWatchable(e).(Watchable); !ok && e.IsMutable() {
+					state.addError(makeSymbolicEvalError(elemNode, state, MUTABLE_NON_WATCHABLE_VALUES_NOT_ALLOWED_AS_ELEMENTS_OF_WATCHABLE))
+					break
+				}
+			}
+		}
+
+		//the result is always a new list, even if there is a single element.
+
+		if hasUnknownLen {
+			generalElements = append(generalElements, SerializablesToValues(elements)...)
+			return NewListOf(AsSerializableChecked(joinValues(generalElements))), nil
+		}
+		return NewList(elements...), nil
 	default:
 		state.addError(makeSymbolicEvalError(n, state, CONCATENATION_SUPPORTED_TYPES_EXPLANATION))
 		return ANY, nil
@@ -4968,6 +5443,7 @@ func evalExtractionExpression(n *parse.ExtractionExpression, state *State, optio
 	}
 
 	ignoreProps := false
+	leftObject, _ := AsIprops(left).(*Object)
 
 	switch AsIprops(left).(type) {
 	case *DynamicValue:
@@ -4987,7 +5463,16 @@ func evalExtractionExpression(n *parse.ExtractionExpression, state *State, optio
 			result.static[name] = getStatic(ANY_SERIALIZABLE)
 		} else {
 			result.entries[name] = symbolicMemb(left, name, false, n, state).(Serializable)
-			result.static[name] = getStatic(result.entries[name])
+
+			//the static of the property in the source object is preserved if present.
+			var static Pattern
+			if leftObject != nil {
+				_, static, _ = leftObject.GetProperty(name)
+			}
+			if static == nil {
+				static = getStatic(result.entries[name])
+			}
+			result.static[name] = static
 		}
 	}
 	return result, nil
@@ -5013,8 +5498,13 @@ func evalIndexExpression(n *parse.IndexExpression, state *State, options evalOpt
 	}
 
 	if indexable, ok := asIndexable(val).(Indexable); ok {
-		if intIndex != nil && intIndex.hasValue && indexable.HasKnownLen() && (intIndex.value < 0 || intIndex.value >= int64(indexable.KnownLen())) {
+		//a guard such as `i < len(list)` does not protect against negative indexes.
+		if intIndex != nil && intIndex.hasValue && indexable.HasKnownLen() &&
+			(intIndex.value < 0 || (intIndex.value >= int64(indexable.KnownLen()) && !isIndexGuarded(n.Index, n.Indexed, state))) {
 			state.addError(makeSymbolicEvalError(n.Index, state, INDEX_IS_OUT_OF_BOUNDS))
+		} else if pair, ok := indexable.(*OrderedPair); ok && intIndex != nil && intIndex.hasValue {
+			//pairs are immutable so the element at a known index is known.
+			return pair.ElementAt(int(intIndex.value)), nil
 		}
 		return indexable.Element(), nil
 	}
@@ -5023,6 +5513,19 @@ func evalIndexExpression(n *parse.IndexExpression, state *State, options evalOpt
 	return ANY, nil
 }
 
+// isIndexGuarded returns true if the index expression is inside a block guarded by a check such as `i < len(list)`.
+func isIndexGuarded(indexNode, indexedNode parse.Node, state *State) bool {
+	index, ok := parse.GetNameIfVariable(indexNode)
+	if !ok {
+		return false
+	}
+	indexed, ok := parse.GetNameIfVariable(indexedNode)
+	if !ok {
+		return false
+	}
+	return state.isIndexInBounds(index, indexed)
+}
+
 func evalSliceExpression(n *parse.SliceExpression, state *State, options evalOptions) (Value, error) {
 	slice, err := _symbolicEval(n.Indexed, state, evalOptions{
 		doubleColonExprAncestorChain: append(slices.Clone(options.doubleColonExprAncestorChain), n),
@@ -5148,10 +5651,11 @@ func evalPatternNamespaceDefinition(n *parse.PatternNamespaceDefinition, state *
 
 func evalTestsuiteExpression(n *parse.TestSuiteExpression, state *State, options evalOptions) (Value, error) {
 	var testedProgram *TestedProgram
+	var name *String
 
 	if n.Meta != nil {
 		var err error
-		_, testedProgram, err = checkTestItemMeta(n.Meta, state, false)
+		_, testedProgram, name, err = checkTestItemMeta(n.Meta, state, false)
 		if err != nil {
 			return nil, err
 		}
@@ -5197,20 +5701,22 @@ func evalTestsuiteExpression(n *parse.TestSuiteExpression, state *State, options
 		state.addWarning(warning)
 	}
 
-	return &TestSuite{}, nil
+	return NewTestSuite(name), nil
 }
 
 func evalTestcaseExpression(n *parse.TestCaseExpression, state *State, options evalOptions) (Value, error) {
 	var currentTest *CurrentTest = ANY_CURRENT_TEST
 	var testedProgram *TestedProgram
+	var name *String
 
 	if n.Meta != nil {
-		test, program, err := checkTestItemMeta(n.Meta, state, true)
+		test, program, testName, err := checkTestItemMeta(n.Meta, state, true)
 		if err != nil {
 			return nil, err
 		}
 		currentTest = test
 		testedProgram = program
+		name = testName
 	} else if state.testedProgram != nil {
 		//inherit tested program
 		testedProgram = state.testedProgram
@@ -5257,7 +5763,7 @@ func evalTestcaseExpression(n *parse.TestCaseExpression, state *State, options e
 		state.addWarning(warning)
 	}
 
-	return &TestCase{}, nil
+	return NewTestCase(name), nil
 }
 
 func evalLifetimejobExpression(n *parse.LifetimejobExpression, state *State, options evalOptions) (Value, error) {
@@ -5367,9 +5873,8 @@ func evalStringTemplateLiteral(n *parse.StringTemplateLiteral, state *State, opt
 			}
 
 			memberName := namespaceMembExpr.MemberName.Name
-			_, ok := namespace.entries[memberName]
-			if !ok {
-				state.addError(makeSymbolicEvalError(n, state, fmtCannotInterpolateMemberOfPatternNamespaceDoesNotExist(memberName, namespaceName)))
+			if _, err := resolveTemplateNamespaceMember(namespace, namespaceName, memberName); err != nil {
+				state.addError(makeSymbolicEvalError(n, state, err.Error()))
 				return &CheckedString{}, nil
 			}
 		}
@@ -5386,10 +5891,8 @@ func evalStringTemplateLiteral(n *parse.StringTemplateLiteral, state *State, opt
 		case *parse.StringTemplateSlice:
 		case *parse.StringTemplateInterpolation:
 			if s.Type != "" {
-				memberName := s.Type
-				_, ok := namespace.entries[memberName]
-				if !ok {
-					state.addError(makeSymbolicEvalError(slice, state, fmtCannotInterpolateMemberOfPatternNamespaceDoesNotExist(memberName, namespaceName)))
+				if _, err := resolveTemplateNamespaceMember(namespace, namespaceName, s.Type); err != nil {
+					state.addError(makeSymbolicEvalError(slice, state, err.Error()))
 					return &CheckedString{}, nil
 				}
 			}
@@ -5419,6 +5922,24 @@ func evalStringTemplateLiteral(n *parse.StringTemplateLiteral, state *State, opt
 	return &CheckedString{}, nil
 }
 
+// resolveTemplateNamespaceMember returns the member of a pattern namespace used by a string template literal,
+// the returned error explains why the member cannot be used for interpolation.
+func resolveTemplateNamespaceMember(namespace *PatternNamespace, namespaceName string, memberName string) (Pattern, error) {
+	if namespace == nil {
+		return nil, errors.New(fmtCannotInterpolatePatternNamespaceDoesNotExist(namespaceName))
+	}
+
+	if namespace.entries == nil { //any pattern namespace
+		return ANY_PATTERN, nil
+	}
+
+	member, ok := namespace.entries[memberName]
+	if !ok {
+		return nil, errors.New(fmtCannotInterpolateMemberOfPatternNamespaceDoesNotExist(memberName, namespaceName))
+	}
+	return member, nil
+}
+
 func evalXMLExpression(n *parse.XMLExpression, state *State, options evalOptions) (Value, error) {
 
 	var namespaceErrorNode parse.Node = n
@@ -5477,10 +5998,14 @@ func evalXMLExpression(n *parse.XMLExpression, state *State, options evalOptions
 
 		if goFn.fn != nil {
 			checkXMLInterpolation := state.checkXMLInterpolation
+			checkXMLAttribute := state.checkXMLAttribute
 			defer func() {
 				state.checkXMLInterpolation = checkXMLInterpolation
+				state.checkXMLAttribute = checkXMLAttribute
 			}()
-			state.checkXMLInterpolation = xmlInterpolationCheckingFunctions[reflect.ValueOf(goFn.fn).Pointer()]
+			fnPtr := reflect.ValueOf(goFn.fn).Pointer()
+			state.checkXMLInterpolation = xmlInterpolationCheckingFunctions[fnPtr]
+			state.checkXMLAttribute = xmlAttributeCheckingFunctions[fnPtr]
 		}
 
 		elem, err := symbolicEval(n.Element, state)
@@ -5519,16 +6044,23 @@ func evalXMLElement(n *parse.XMLElement, state *State, options evalOptions) (Val
 		for _, attr := range n.Opening.Attributes {
 			regularAttr, ok := attr.(*parse.XMLAttribute)
 			if ok {
-				name := regularAttr.Name.(*parse.IdentifierLiteral).Name
+				attrName := regularAttr.Name.(*parse.IdentifierLiteral).Name
 				if regularAttr.Value == nil {
-					attrs[name] = ANY_STRING
+					attrs[attrName] = ANY_STRING
 					continue
 				}
 				val, err := symbolicEval(regularAttr.Value, state)
 				if err != nil {
 					return nil, err
 				}
-				attrs[name] = val
+				attrs[attrName] = val
+
+				if state.checkXMLAttribute != nil {
+					msg := state.checkXMLAttribute(regularAttr.Value, name, attrName, val)
+					if msg != "" {
+						state.addError(makeSymbolicEvalError(regularAttr.Value, state, msg))
+					}
+				}
 			} else if _, ok := attr.(*parse.HyperscriptAttributeShorthand); ok {
 				attrs[inoxconsts.HYPERSCRIPT_ATTRIBUTE_NAME] = ANY_STRING
 			}
@@ -5799,6 +6331,11 @@ func evalExtendStatement(n *parse.ExtendStatement, state *State, options evalOpt
 			}
 		}
 
+		if state.ctx.IsPropertyDefinedByExtension(pattern, key) {
+			state.addError(makeSymbolicEvalError(prop.Key, state, fmtAnotherExtensionAlreadyDefinesAnXProperty(key)))
+			continue
+		}
+
 		switch v := prop.Value.(type) {
 		case *parse.FunctionExpression:
 			prevNextSelf, restoreNextSelf := state.getNextSelf()
@@ -6280,11 +6817,20 @@ func handleConstraints(obj *Object, block *parse.InitializationBlock, state *Sta
 		return fmt.Errorf("constraints: error when walking the initialization block: %w", err)
 	}
 
-	//
+	//the constraint expressions are evaluated in a scope only exposing the object as self.
+
+	state.pushScope()
+	defer state.popScope()
+
+	state.setSelf(obj)
+	defer state.unsetSelf()
 
 	for _, stmt := range block.Statements {
 		switch stmt.(type) {
 		case *parse.BinaryExpression:
+			if _, err := symbolicEval(stmt, state); err != nil {
+				return err
+			}
 
 			constraint := &ComplexPropertyConstraint{
 				Expr: stmt,
@@ -6307,8 +6853,12 @@ func handleConstraints(obj *Object, block *parse.InitializationBlock, state *Sta
 }
 
 func makeSymbolicEvalError(node parse.Node, state *State, msg string) SymbolicEvaluationError {
+	return makeSymbolicEvalErrorWithSpan(node.Base().Span, state, msg)
+}
+
+func makeSymbolicEvalErrorWithSpan(nodeSpan parse.NodeSpan, state *State, msg string) SymbolicEvaluationError {
 	locatedMsg := msg
-	location := state.getErrorMesssageLocation(node)
+	location := state.getErrorMesssageLocationOfSpan(nodeSpan)
 	if state.Module != nil {
 		locatedMsg = fmt.Sprintf("check(symbolic): %s: %s", location, msg)
 	}
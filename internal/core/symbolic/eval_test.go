@@ -2,12 +2,14 @@ package symbolic
 
 import (
 	"context"
+	"math"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/go-git/go-billy/v5/memfs"
 	"github.com/go-git/go-billy/v5/util"
+	"github.com/inoxlang/inox/internal/core/permkind"
 	"github.com/inoxlang/inox/internal/globals/globalnames"
 	"github.com/inoxlang/inox/internal/parse"
 	"github.com/inoxlang/inox/internal/utils"
@@ -132,6 +134,55 @@ func TestSymbolicEval(t *testing.T) {
 		assert.Equal(t, NewOption("name", NewString("foo")), res)
 	})
 
+	t.Run("option expression with a declared option pattern", func(t *testing.T) {
+		t.Run("value matching the pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				a = --verbose=true
+				b = --verbose=false
+			`)
+			state.ctx.AddNamedPattern("verbose", NewOptionPattern("verbose", &TypePattern{val: ANY_BOOL}), false)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+
+		t.Run("value not matching the pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				a = --verbose=true
+				return --verbose=1
+			`)
+			state.ctx.AddNamedPattern("verbose", NewOptionPattern("verbose", &TypePattern{val: ANY_BOOL}), false)
+
+			intLit := parse.FindNode(n, (*parse.IntLiteral)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(intLit, state, fmtOptionValueNotMatchingDeclaredPattern("verbose", INT_1, &TypePattern{val: ANY_BOOL})),
+			}, state.errors())
+			assert.Equal(t, NewOption("verbose", INT_1), res)
+		})
+
+		t.Run("several patterns declared for the option", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return --verbose=1
+			`)
+			state.ctx.AddNamedPattern("verbose", NewOptionPattern("verbose", &TypePattern{val: ANY_BOOL}), false)
+			state.ctx.AddNamedPattern("verbose-int", NewOptionPattern("verbose", &TypePattern{val: ANY_INT}), false)
+
+			optionExpr := parse.FindNode(n, (*parse.OptionExpression)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(optionExpr, state, fmtSeveralPatternsDeclaredForOption("verbose", []string{"verbose", "verbose-int"})),
+			}, state.warnings())
+			assert.Equal(t, NewOption("verbose", INT_1), res)
+		})
+	})
+
 	t.Run("property name literal", func(t *testing.T) {
 		n, state := MakeTestStateAndChunk(".name")
 		res, err := symbolicEval(n, state)
@@ -189,6 +240,70 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Empty(t, state.errors())
 			assert.Equal(t, NewIntRange(INT_1, MAX_INT, false), res)
 		})
+
+		t.Run("step", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk("0..10 step 2")
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			intRange := NewSteppedIntRange(INT_0, NewInt(10), INT_2)
+			if !assert.Equal(t, intRange, res) {
+				return
+			}
+
+			yes, possible := intRange.Contains(NewInt(4))
+			assert.True(t, yes)
+			assert.True(t, possible)
+
+			yes, possible = intRange.Contains(NewInt(5))
+			assert.False(t, yes)
+			assert.False(t, possible)
+		})
+	})
+
+	t.Run("upper-bound range expression", func(t *testing.T) {
+		t.Run("known int upper bound", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk("..2")
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewUpperBoundIntRange(INT_2), res)
+		})
+
+		t.Run("unknown int upper bound", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk("..$$n")
+			state.setGlobal("n", ANY_INT, GlobalConst)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT_RANGE, res)
+		})
+
+		t.Run("known float upper bound", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk("..2.0")
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewUpperBoundFloatRange(FLOAT_2, true), res)
+		})
+
+		t.Run("two ranges with the same known upper bound", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				a = ..2
+				b = ..2
+				c = ..3
+				return [a, b, c]
+			`)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			ranges := res.(*List).elements
+			assert.True(t, ranges[0].Test(ranges[1], RecTestCallState{}))
+			assert.False(t, ranges[0].Test(ranges[2], RecTestCallState{}))
+		})
 	})
 
 	t.Run("float range literal", func(t *testing.T) {
@@ -369,7 +484,92 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, EMPTY_READONLY_LIST, res)
+
+			expectedList := NewEmptyListOf(ANY_INT)
+			expectedList.readonly = true
+			assert.Equal(t, expectedList, res)
+		})
+
+		t.Run("empty list passed as an argument of type []int", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn f(list []int){
+					return list
+				}
+				return f([])
+			`)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewEmptyListOf(ANY_INT), res)
+		})
+
+		t.Run("empty list assigned to a variable of type []int", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				var list %[]int = []
+				return list
+			`)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewEmptyListOf(ANY_INT), res)
+		})
+
+		t.Run("appending an element of the wrong type to an empty list in a typed context", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				var list %[]int = []
+				list.append("a")
+			`)
+			callExpr := n.Statements[1]
+			argNode := parse.FindNode(n, (*parse.QuotedStringLiteral)(nil), nil)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(callExpr, state, INVALID_MUTATION),
+				makeSymbolicEvalError(argNode, state, FmtInvalidArg(0, NewString("a"), ANY_INT)),
+			}, state.errors())
+		})
+
+		t.Run("popping an element from an empty list in a typed context", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				var list %[]int = []
+				list.pop()
+			`)
+			callExpr := n.Statements[1]
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(callExpr, state, CANNOT_POP_FROM_EMPTY_LIST),
+			}, state.errors())
+		})
+
+		t.Run("dequeuing an element from an empty list in a typed context", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				var list %[]int = []
+				list.dequeue()
+			`)
+			callExpr := n.Statements[1]
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(callExpr, state, CANNOT_DEQUEUE_FROM_EMPTY_LIST),
+			}, state.errors())
+		})
+
+		t.Run("popping an element after appending an element to an empty list in a typed context", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				var list %[]int = []
+				list.append(1)
+				list.pop()
+				return list
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewListOf(ANY_INT), res)
 		})
 
 		t.Run("readonly lists should not have non-readonly elements", func(t *testing.T) {
@@ -1998,6 +2198,26 @@ func TestSymbolicEval(t *testing.T) {
 			}, res)
 		})
 
+		t.Run("_constraints_: comparison of properties with different types", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`{
+				a: 1
+				b: "2"
+
+				_constraints_ {
+					(self.a < self.b)
+				}
+			}`)
+			res, err := symbolicEval(n, state)
+
+			binExpr := parse.FindNode(state.Module.mainChunk.Node, (*parse.BinaryExpression)(nil), nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(binExpr, state, OPERANDS_NOT_COMPARABLE_BECAUSE_DIFFERENT_TYPES),
+			}, state.errors())
+			assert.IsType(t, (*Object)(nil), res)
+		})
+
 		t.Run("readonly", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				fn f(obj readonly {}){
@@ -2578,6 +2798,32 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, NewString("a"), res)
 		})
 
+		t.Run("known string", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				s = "abc"
+				return s[0]
+			`)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_BYTE, res)
+		})
+
+		t.Run("index is out of bounds (known string)", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				s = "abc"
+				return s[3]
+			`)
+			intLit := parse.FindNode(n, (*parse.IntLiteral)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(intLit, state, INDEX_IS_OUT_OF_BOUNDS),
+			}, state.errors())
+			assert.Equal(t, ANY_BYTE, res)
+		})
+
 		t.Run("start index is out of bounds (negative)", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				v = ["a"]
@@ -2608,6 +2854,106 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, NewString("a"), res)
 		})
 
+		t.Run("index guarded by a check of the length (len())", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				v = ["a"]
+				i = 1
+				if (i < len(v)) {
+					return v[i]
+				}
+			`)
+			state.setGlobal("len", &GoFunction{
+				fn: func(ctx *Context, v Indexable) *Int {
+					return ANY_INT
+				},
+			}, GlobalConst)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+
+		t.Run("index guarded by a check of the length (.len)", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				v = ["a"]
+				i = 1
+				if (v.len > i) {
+					return v[i]
+				}
+			`)
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+
+		t.Run("index guarded by a check of the length in the alternate block", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				v = ["a"]
+				i = 1
+				if (i >= v.len) {
+					return nil
+				} else {
+					return v[i]
+				}
+			`)
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+
+		t.Run("guarded index is reassigned", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				v = ["a"]
+				i = 0
+				if (i < v.len) {
+					i = 1
+					return v[i]
+				}
+			`)
+			_, err := symbolicEval(n, state)
+			indexExpr := parse.FindNode(n, (*parse.IndexExpression)(nil), nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(indexExpr.Index, state, INDEX_IS_OUT_OF_BOUNDS),
+			}, state.errors())
+		})
+
+		t.Run("guarded negative index", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				v = ["a"]
+				i = -1
+				if (i < v.len) {
+					return v[i]
+				}
+			`)
+			_, err := symbolicEval(n, state)
+			indexExpr := parse.FindNode(n, (*parse.IndexExpression)(nil), nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(indexExpr.Index, state, INDEX_IS_OUT_OF_BOUNDS),
+			}, state.errors())
+		})
+
+		t.Run("index checked against the length of another sequence", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				v = ["a"]
+				w = ["a", "b"]
+				i = 1
+				if (i < w.len) {
+					return v[i]
+				}
+			`)
+			_, err := symbolicEval(n, state)
+			indexExpr := parse.FindNode(n, (*parse.IndexExpression)(nil), nil)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(indexExpr.Index, state, INDEX_IS_OUT_OF_BOUNDS),
+			}, state.errors())
+		})
+
 		t.Run("list of unknown length", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				return $$v[0]
@@ -2656,7 +3002,7 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, []SymbolicEvaluationError{
 				makeSymbolicEvalError(indexExpr, state, fmtStartIndexIsNotAnIntButA(NewString("0"))),
 			}, state.errors())
-			assert.Equal(t, NewListOf(ANY_SERIALIZABLE), res)
+			assert.Equal(t, NewListOf(NewString("a")), res)
 		})
 
 		t.Run("end index is not an integer", func(t *testing.T) {
@@ -2671,7 +3017,7 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, []SymbolicEvaluationError{
 				makeSymbolicEvalError(indexExpr, state, fmtEndIndexIsNotAnIntButA(NewString("1"))),
 			}, state.errors())
-			assert.Equal(t, NewListOf(ANY_SERIALIZABLE), res)
+			assert.Equal(t, NewListOf(NewString("a")), res)
 		})
 
 		t.Run("indexed it not a sequence", func(t *testing.T) {
@@ -2697,7 +3043,30 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, NewListOf(ANY_SERIALIZABLE), res)
+			assert.Equal(t, NewList(NewString("a")), res)
+		})
+
+		t.Run("known string", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				s = "hello"
+				return $s[1:3]
+			`)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewString("el"), res)
+		})
+
+		t.Run("string method called on the slice of a known string", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				s = "hello"
+				sub = $s[1:3]
+				return [sub, sub.has_prefix("e")]
+			`)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewList(NewString("el"), ANY_BOOL), res)
 		})
 
 		t.Run("start index is out of bounds (negative)", func(t *testing.T) {
@@ -2712,7 +3081,7 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, []SymbolicEvaluationError{
 				makeSymbolicEvalError(intLit, state, START_INDEX_IS_OUT_OF_BOUNDS),
 			}, state.errors())
-			assert.Equal(t, NewListOf(ANY_SERIALIZABLE), res)
+			assert.Equal(t, NewListOf(NewString("a")), res)
 		})
 
 		t.Run("start index is out of bounds (positive)", func(t *testing.T) {
@@ -2727,7 +3096,7 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, []SymbolicEvaluationError{
 				makeSymbolicEvalError(intLit, state, START_INDEX_IS_OUT_OF_BOUNDS),
 			}, state.errors())
-			assert.Equal(t, NewListOf(ANY_SERIALIZABLE), res)
+			assert.Equal(t, NewList(), res)
 		})
 
 		t.Run("end index should less or equal to start index", func(t *testing.T) {
@@ -2742,30 +3111,64 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, []SymbolicEvaluationError{
 				makeSymbolicEvalError(intLit, state, END_INDEX_SHOULD_BE_LESS_OR_EQUAL_START_INDEX),
 			}, state.errors())
-			assert.Equal(t, NewListOf(ANY_SERIALIZABLE), res)
+			assert.Equal(t, NewListOf(AsSerializableChecked(NewMultivalue(NewString("a"), NewString("b")))), res)
 		})
 
-		t.Run("list of unknown length", func(t *testing.T) {
+		t.Run("tuple of known length: known indexes", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				return $$v[0:]
+				t = #[1, 2, 3]
+				return [t[1:], t[:2], t[1:10]]
 			`)
-			state.setGlobal("v", &List{generalElement: ANY_STRING}, GlobalConst)
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, &List{generalElement: ANY_STRING}, res)
+			assert.Equal(t, NewList(
+				NewTuple(INT_2, INT_3),
+				NewTuple(INT_1, INT_2),
+				NewTuple(INT_2, INT_3),
+			), res)
 		})
 
-		t.Run("rune slice", func(t *testing.T) {
+		t.Run("tuple of known length: unknown start index", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				return $$v[0:]
+				t = #[1, 2, 3]
+				return t[$$i:]
 			`)
-			state.setGlobal("v", ANY_RUNE_SLICE, GlobalConst)
+			state.setGlobal("i", ANY_INT, GlobalConst)
+
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_RUNE_SLICE, res)
-		})
+
+			if !assert.IsType(t, (*Tuple)(nil), res) {
+				return
+			}
+			tuple := res.(*Tuple)
+			assert.False(t, tuple.HasKnownLen())
+			assert.Equal(t, AsSerializableChecked(NewMultivalue(INT_1, INT_2, INT_3)), tuple.Element())
+		})
+
+		t.Run("list of unknown length", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return $$v[0:]
+			`)
+			state.setGlobal("v", &List{generalElement: ANY_STRING}, GlobalConst)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &List{generalElement: ANY_STRING}, res)
+		})
+
+		t.Run("rune slice", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return $$v[0:]
+			`)
+			state.setGlobal("v", ANY_RUNE_SLICE, GlobalConst)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_RUNE_SLICE, res)
+		})
 
 		t.Run("byte slice", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
@@ -2800,6 +3203,53 @@ func TestSymbolicEval(t *testing.T) {
 				},
 			}, res)
 		})
+		t.Run("static types of the properties should be preserved", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				var v %{a: %| %str | %int, b: bool} = {a: "a", b: true}
+				return $v.{a, b}
+			`)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &Object{
+				entries: map[string]Serializable{
+					"a": NewString("a"),
+					"b": TRUE,
+				},
+				static: map[string]Pattern{
+					"a": &UnionPattern{
+						cases: []Pattern{
+							state.ctx.ResolveNamedPattern("str"),
+							state.ctx.ResolveNamedPattern("int"),
+						},
+					},
+					"b": state.ctx.ResolveNamedPattern("bool"),
+				},
+			}, res)
+		})
+
+		t.Run("static type of a property should be preserved: assignment of an incompatible value", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				var v %{a: %| %str | %int} = {a: "a"}
+				obj = $v.{a}
+				$obj.a = true
+			`)
+			assignment := n.Statements[2].(*parse.Assignment)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+
+			expectedStatic := &UnionPattern{
+				cases: []Pattern{
+					state.ctx.ResolveNamedPattern("str"),
+					state.ctx.ResolveNamedPattern("int"),
+				},
+			}
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(assignment, state, fmtNotAssignableToPropOfType(TRUE, expectedStatic)),
+			}, state.errors())
+		})
+
 		t.Run("dynamic values are not supported", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				v = {a: {b: 1}}
@@ -2833,6 +3283,129 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, ANY_INT, res)
 		})
 
+		t.Run("+: (int, float): mixed numeric operands are not allowed", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(1 + 1.0)`)
+			res, err := symbolicEval(n, state)
+
+			rightOperand := n.Statements[0].(*parse.BinaryExpression).Right
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(rightOperand, state, fmtRightOperandForIntArithmetic(NewFloat(1.0), parse.Add)),
+			}, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("+: (float, int): mixed numeric operands are not allowed", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(1.0 + 1)`)
+			res, err := symbolicEval(n, state)
+
+			rightOperand := n.Statements[0].(*parse.BinaryExpression).Right
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(rightOperand, state, fmtRightOperandForFloatArithmetic(INT_1, parse.Add)),
+			}, state.errors())
+			assert.Equal(t, ANY_FLOAT, res)
+		})
+
+		t.Run("+: (multivalue of integers, int)", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(v + 1)`)
+			state.setGlobal("v", NewMultivalue(INT_1, INT_2), GlobalConst)
+
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("+: (int, multivalue of integers)", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(1 + v)`)
+			state.setGlobal("v", NewMultivalue(INT_1, INT_2), GlobalConst)
+
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("+: (multivalue of floats, int)", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(v + 1)`)
+			state.setGlobal("v", NewMultivalue(NewFloat(1.0), NewFloat(2.0)), GlobalConst)
+
+			rightOperand := n.Statements[0].(*parse.BinaryExpression).Right
+
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(rightOperand, state, fmtRightOperandForFloatArithmetic(INT_1, parse.Add)),
+			}, state.errors())
+			assert.Equal(t, ANY_FLOAT, res)
+		})
+
+		t.Run("+: known integers, no overflow", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(9223372036854775806 + 1)`)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("+: known integers, overflow: warning", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(9223372036854775807 + 1)`)
+			res, err := symbolicEval(n, state)
+
+			binExpr := n.Statements[0].(*parse.BinaryExpression)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(binExpr, state, fmtIntArithmeticOverflows(math.MaxInt64, parse.Add, 1)),
+			}, state.warnings())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("location of an overflow warning", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk("a = 1\nb = (9223372036854775807 + 1)")
+			_, err := symbolicEval(n, state)
+
+			binExpr := parse.FindNode(n, (*parse.BinaryExpression)(nil), nil)
+
+			assert.NoError(t, err)
+			if !assert.Len(t, state.warnings(), 1) {
+				return
+			}
+			assert.Equal(t, parse.SourcePositionStack{
+				{
+					SourceName:  "",
+					StartLine:   2,
+					StartColumn: 5,
+					EndLine:     2,
+					EndColumn:   30,
+					Span:        binExpr.Span,
+				},
+			}, state.warnings()[0].LocationStack())
+		})
+
+		t.Run("*: known integers, overflow: warning", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(4611686018427387904 * 2)`)
+			res, err := symbolicEval(n, state)
+
+			binExpr := n.Statements[0].(*parse.BinaryExpression)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(binExpr, state, fmtIntArithmeticOverflows(1<<62, parse.Mul, 2)),
+			}, state.warnings())
+			assert.Equal(t, ANY_INT, res)
+		})
+
 		t.Run("+: (duration, duration)", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`(d1 + d2)`)
 			duration := NewDuration(time.Hour)
@@ -3035,6 +3608,86 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, ANY_BOOL, res)
 		})
 
+		t.Run("substrof: known strings, known true result", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`("b" substrof "abc")`)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, TRUE, res)
+		})
+
+		t.Run("substrof: known strings, known false result", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`("d" substrof "abc")`)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, FALSE, res)
+		})
+
+		t.Run("substrof: unknown string", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`("b" substrof $$s)`)
+			state.setGlobal("s", ANY_STRING, GlobalConst)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_BOOL, res)
+		})
+
+		t.Run("as: right operand is not a pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(1 as 1)`)
+			res, err := symbolicEval(n, state)
+
+			expr := n.Statements[0].(*parse.BinaryExpression)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(expr.Right, state, fmtRightOperandOfBinaryShouldBe(parse.As, "pattern", "%int(1)")),
+			}, state.errors())
+			assert.Equal(t, ANY, res)
+		})
+
+		t.Run("as: (int | string) to %int", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn f(v %| int | str){
+					var i %int = (v as %int)
+					var j %int = v
+					return i
+				}
+			`)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			fnDecl := parse.FindNode(n, (*parse.FunctionDeclaration)(nil), nil)
+			fn, ok := state.symbolicData.GetMostSpecificNodeValue(fnDecl.Function)
+			if !assert.True(t, ok) {
+				return
+			}
+			assert.Equal(t, ANY_INT, fn.(*InoxFunction).result)
+		})
+
+		t.Run("as: (int | string) to %bool", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn f(v %| int | str){
+					return (v as %bool)
+				}
+			`)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+
+			expr := parse.FindNode(n, (*parse.BinaryExpression)(nil), nil)
+			pattern, _ := state.symbolicData.GetMostSpecificNodeValue(expr.Right)
+
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(expr, state, fmtValueCannotBeConvertedTo(NewMultivalue(ANY_INT, ANY_STR_LIKE), pattern.(Pattern))),
+			}, state.errors())
+		})
+
 		t.Run("match: right operand is a path pattern", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`(/home/user/ match %/home/user/...)`)
 			res, err := symbolicEval(n, state)
@@ -3122,6 +3775,51 @@ func TestSymbolicEval(t *testing.T) {
 				}, state.errors())
 				assert.Equal(t, ANY_BOOL, res)
 			})
+
+			t.Run("in: known tuple contains the value", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`(2 in #[1, 2, 3])`)
+				res, err := symbolicEval(n, state)
+
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+				assert.Equal(t, TRUE, res)
+			})
+
+			t.Run("in: known tuple does not contain the value", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`(4 in #[1, 2, 3])`)
+				res, err := symbolicEval(n, state)
+
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+				assert.Equal(t, FALSE, res)
+			})
+
+			t.Run("in: value is not known", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`(int in #[1, 2, 3])`)
+				res, err := symbolicEval(n, state)
+
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+				assert.Equal(t, ANY_BOOL, res)
+			})
+
+			t.Run("in: known record", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`(1 in #{k: 1})`)
+				res, err := symbolicEval(n, state)
+
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+				assert.Equal(t, TRUE, res)
+			})
+
+			t.Run("not-in: known tuple contains the value", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`(2 not-in #[1, 2, 3])`)
+				res, err := symbolicEval(n, state)
+
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+				assert.Equal(t, FALSE, res)
+			})
 		})
 
 		t.Run("range expression", func(t *testing.T) {
@@ -3219,6 +3917,27 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, NewOrderedPair(ANY_SERIALIZABLE, INT_1), res)
 		})
 
+		t.Run("pair: element types are preserved", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				pair = (1, "a")
+				first = pair[0]
+				second = pair[1]
+				assign a b = pair
+				return [first, second, a, b]
+			`)
+			_, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			returnStmt := parse.FindNode(n, (*parse.ReturnStatement)(nil), nil)
+			pair, _ := state.symbolicData.GetMostSpecificNodeValue(n.Statements[0].(*parse.Assignment).Right)
+			assert.Equal(t, NewOrderedPair(INT_1, NewString("a")), pair)
+
+			list, _ := state.symbolicData.GetMostSpecificNodeValue(returnStmt.Expr)
+			assert.Equal(t, NewList(INT_1, NewString("a"), INT_1, NewString("a")), list)
+		})
+
 		t.Run("pair: right operand should be be immutable", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`(1 , {})`)
 			res, err := symbolicEval(n, state)
@@ -3232,18 +3951,95 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, NewOrderedPair(INT_1, ANY_SERIALIZABLE), res)
 		})
 
-	})
+		t.Run("nil coalescing: both operands are integers", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(a ?? b)`)
+			state.setGlobal("a", ANY_INT, GlobalConst)
+			state.setGlobal("b", ANY_INT, GlobalConst)
+			res, err := symbolicEval(n, state)
 
-	t.Run("unary expression: !: operand is a string", func(t *testing.T) {
-		n, state := MakeTestStateAndChunk(`!"string"`)
-		res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
 
-		assert.NoError(t, err)
-		assert.Equal(t, []SymbolicEvaluationError{
-			makeSymbolicEvalError(n, state, fmtOperandOfBoolNegateShouldBeBool(NewString("string"))),
-		}, state.errors())
-		assert.Equal(t, ANY_BOOL, res)
-	})
+		t.Run("nil coalescing: left operand is never nil", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(a ?? b)`)
+			state.setGlobal("a", ANY_INT, GlobalConst)
+			state.setGlobal("b", ANY_STRING, GlobalConst)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("nil coalescing: left operand is always nil", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(nil ?? b)`)
+			state.setGlobal("b", ANY_STRING, GlobalConst)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_STRING, res)
+		})
+
+		t.Run("nil coalescing: left operand is (int | nil), right operand is an integer", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(a ?? b)`)
+			state.setGlobal("a", NewMultivalue(ANY_INT, Nil), GlobalConst)
+			state.setGlobal("b", ANY_INT, GlobalConst)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("nil coalescing: left operand is (int | nil), right operand is a string", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`(a ?? b)`)
+			state.setGlobal("a", NewMultivalue(ANY_INT, Nil), GlobalConst)
+			state.setGlobal("b", ANY_STRING, GlobalConst)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewMultivalue(ANY_INT, ANY_STRING), res)
+		})
+
+		t.Run("nil coalescing chain: only the last operand is never nil", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`((a ?? b) ?? c)`)
+			state.setGlobal("a", NewMultivalue(ANY_INT, Nil), GlobalConst)
+			state.setGlobal("b", NewMultivalue(ANY_INT, Nil), GlobalConst)
+			state.setGlobal("c", ANY_INT, GlobalConst)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("nil coalescing chain: last operand may be nil", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`((a ?? b) ?? c)`)
+			state.setGlobal("a", NewMultivalue(ANY_INT, Nil), GlobalConst)
+			state.setGlobal("b", ANY_STRING, GlobalConst)
+			state.setGlobal("c", NewMultivalue(ANY_BOOL, Nil), GlobalConst)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewMultivalue(ANY_INT, ANY_STRING), res)
+		})
+	})
+
+	t.Run("unary expression: !: operand is a string", func(t *testing.T) {
+		n, state := MakeTestStateAndChunk(`!"string"`)
+		res, err := symbolicEval(n, state)
+
+		assert.NoError(t, err)
+		assert.Equal(t, []SymbolicEvaluationError{
+			makeSymbolicEvalError(n, state, fmtOperandOfBoolNegateShouldBeBool(NewString("string"))),
+		}, state.errors())
+		assert.Equal(t, ANY_BOOL, res)
+	})
 
 	t.Run("unary expression: number negation", func(t *testing.T) {
 		t.Run("invalid operand", func(t *testing.T) {
@@ -3284,6 +4080,55 @@ func TestSymbolicEval(t *testing.T) {
 
 	t.Run("function declaration", func(t *testing.T) {
 
+		t.Run("mutually recursive functions", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn is_even(n int) bool {
+					if (n == 0) {
+						return true
+					}
+					return is_odd((n - 1))
+				}
+
+				fn is_odd(n int) bool {
+					if (n == 0) {
+						return false
+					}
+					return is_even((n - 1))
+				}
+
+				return is_even(4)
+			`)
+			state.ctx.AddNamedPattern("int", &TypePattern{val: ANY_INT}, true)
+			state.ctx.AddNamedPattern("bool", &TypePattern{val: ANY_BOOL}, true)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_BOOL, res)
+		})
+
+		t.Run("mutually recursive functions called before their declarations", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				result = f(1)
+
+				fn f(n int) int {
+					return g(n)
+				}
+
+				fn g(n int) int {
+					return f(n)
+				}
+
+				return result
+			`)
+			state.ctx.AddNamedPattern("int", &TypePattern{val: ANY_INT}, true)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
+
 		t.Run("missing body", func(t *testing.T) {
 			n, state, _ := _makeStateAndChunk(`
 				fn f()
@@ -3443,6 +4288,60 @@ func TestSymbolicEval(t *testing.T) {
 			}, res)
 		})
 
+		t.Run("unused captured local", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				a = int
+				b = "1"
+				fn[a, b] f(){
+					return a
+				}
+			`)
+			state.checkUnusedCapturedLocals = true
+
+			fnExpr := n.Statements[2].(*parse.FunctionDeclaration).Function
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(fnExpr.CaptureList[1], state, fmtCapturedLocalIsNeverRead("b")),
+			}, state.warnings())
+		})
+
+		t.Run("unused captured local: check disabled", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				a = int
+				fn[a] f(){
+					return 1
+				}
+			`)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+		})
+
+		t.Run("captured local only read by a nested function", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				a = int
+				fn[a] f(){
+					return fn[a](){
+						if true {
+							return a
+						}
+						return 1
+					}
+				}
+			`)
+			state.checkUnusedCapturedLocals = true
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+		})
+
 		t.Run("return type specified but missing return", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				fn f() %int {
@@ -3491,6 +4390,33 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Nil(t, res)
 		})
 
+		t.Run("arrow syntax: body expression evaluates to nil", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn f() %int => nil
+			`)
+
+			nilLit := parse.FindNode(n, (*parse.NilLiteral)(nil), nil)
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(nilLit, state, fmtBodyExpressionOfFunctionEvaluatesToNil(ANY_INT)),
+			}, state.errors())
+			assert.Nil(t, res)
+		})
+
+		t.Run("arrow syntax: body expression evaluates to nil and the return type accepts nil", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn f() %int? => nil
+			`)
+
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Nil(t, res)
+		})
+
 		t.Run("invalid return value (annotation is an unprefixed named pattern)", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				fn f() int {
@@ -5302,6 +6228,48 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Empty(t, state.errors())
 			assert.Equal(t, NewMultivalue(ANY_ERR, Nil), res)
 		})
+
+		t.Run("result of a fallible call (signature is func(*Context) error) should be narrowed by a match expression", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				r = f()
+				if (r match %error) {
+					var e %error = r
+				} else {
+					var n %nil = r
+				}
+			`)
+			state.ctx.AddNamedPattern("error", &TypePattern{val: ANY_ERR}, false)
+
+			goFunc := &GoFunction{
+				fn: func(*Context) *Error {
+					return nil
+				},
+			}
+
+			state.setGlobal("f", goFunc, GlobalConst)
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+
+		t.Run("'must' call of a fallible Go function (signature is func(*Context) error)", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return f!()
+			`)
+
+			goFunc := &GoFunction{
+				fn: func(*Context) *Error {
+					return nil
+				},
+			}
+
+			state.setGlobal("f", goFunc, GlobalConst)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, Nil, res)
+		})
+
 		t.Run("no concrete value", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				return f()
@@ -5805,6 +6773,63 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, NewListOf(ANY_INT), res)
 		})
 
+		t.Run("the element appended to an empty list should be known when indexing the list", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				l = []
+				l.append(1)
+				return l[0]
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, INT_1, res)
+		})
+
+		t.Run("appending several times to an empty list should widen the element type", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				l = []
+				l.append(1)
+				l.append("a")
+				return l[0]
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, AsSerializableChecked(NewMultivalue(INT_1, NewString("a"))), res)
+		})
+
+		t.Run("appending an int to a list of (int | str) should not change the element type", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn f(l [](| int | str)){
+					l.append(1)
+					return l[0]
+				}
+				return f
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			fn := res.(*InoxFunction)
+			assert.Equal(t, AsSerializableChecked(NewMultivalue(ANY_INT, ANY_STR_LIKE)), fn.result)
+		})
+
+		t.Run("appending a string to a list of (int | str) with a known element", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				var l [](| int | str) = [1]
+				l.append("a")
+				return l[0]
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, AsSerializableChecked(NewMultivalue(INT_1, NewString("a"))), res)
+		})
+
 		t.Run("it should be an error for a Go method to update its receiver to an incompatible value", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				var l [1] = [1]
@@ -6145,50 +7170,116 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, expectedRecordPattern, res)
 		})
 
-		t.Run("pattern not convertible to readonly", func(t *testing.T) {
-			n, state := MakeTestStateAndChunk(`pattern p = readonly {x: not-convertible}; return %p`)
-			state.ctx.AddNamedPattern("not-convertible", ANY_SERIALIZABLE_PATTERN, true)
-
-			pattern := parse.FindNode(n, (*parse.ObjectPatternLiteral)(nil), nil)
+		t.Run("list pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`pattern p = readonly []int; return %p`)
+			state.ctx.AddNamedPattern("int", &TypePattern{val: ANY_INT}, true)
 
 			res, err := symbolicEval(n, state)
 			if !assert.NoError(t, err) {
 				return
 			}
+			assert.Empty(t, state.errors())
 
-			assert.Equal(t, []SymbolicEvaluationError{
-				makeSymbolicEvalError(pattern, state, FmtPropertyPatternError("x", ErrNotConvertibleToReadonly).Error()),
-			}, state.errors())
+			listPattern, ok := res.(*ListPattern)
+			if !assert.True(t, ok) {
+				return
+			}
+			assert.True(t, listPattern.IsReadonlyPattern())
 
-			expectedObjectPattern := NewInexactObjectPattern(map[string]Pattern{
-				"x": ANY_SERIALIZABLE_PATTERN,
-			}, nil)
-			assert.Equal(t, expectedObjectPattern, res)
+			list := listPattern.SymbolicValue().(*List)
+			assert.True(t, list.IsReadonly())
+			assert.Equal(t, ANY_INT, list.generalElement)
 		})
-	})
-
-	t.Run("pipe statement", func(t *testing.T) {
-		t.Run("ok", func(t *testing.T) {
-			n, state := MakeTestStateAndChunk(`
-				fn get_int(){
-					return Array(int, nil)
-				}
-	
-				fn addOne(i %int){
-					$$result = (i + int)
-				}
-	
-				get_int | addOne $
-				return $$result
-			`)
 
-			state.setGlobal("Array", WrapGoFunction(NewArray), GlobalConst)
+		t.Run("list pattern with mutable elements", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`pattern p = readonly [{}, []{}]; return %p`)
 
 			res, err := symbolicEval(n, state)
-			assert.NoError(t, err)
+			if !assert.NoError(t, err) {
+				return
+			}
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_INT, res)
-		})
+
+			listPattern, ok := res.(*ListPattern)
+			if !assert.True(t, ok) {
+				return
+			}
+
+			list := listPattern.SymbolicValue().(*List)
+			assert.True(t, list.IsReadonly())
+
+			if !assert.Len(t, list.elements, 2) {
+				return
+			}
+			assert.True(t, IsReadonly(list.elements[0]))
+
+			innerList := list.elements[1].(*List)
+			assert.True(t, innerList.IsReadonly())
+			assert.True(t, IsReadonly(innerList.generalElement))
+		})
+
+		t.Run("tuple pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`pattern p = readonly #[]int; return %p`)
+			state.ctx.AddNamedPattern("int", &TypePattern{val: ANY_INT}, true)
+
+			res, err := symbolicEval(n, state)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Empty(t, state.errors())
+
+			tuplePattern, ok := res.(*TuplePattern)
+			if !assert.True(t, ok) {
+				return
+			}
+			assert.True(t, tuplePattern.IsReadonlyPattern())
+			assert.True(t, IsReadonlyOrImmutable(tuplePattern.SymbolicValue()))
+		})
+
+		t.Run("pattern not convertible to readonly", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`pattern p = readonly {x: not-convertible}; return %p`)
+			state.ctx.AddNamedPattern("not-convertible", ANY_SERIALIZABLE_PATTERN, true)
+
+			pattern := parse.FindNode(n, (*parse.ObjectPatternLiteral)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(pattern, state, FmtPropertyPatternError("x", ErrNotConvertibleToReadonly).Error()),
+			}, state.errors())
+
+			expectedObjectPattern := NewInexactObjectPattern(map[string]Pattern{
+				"x": ANY_SERIALIZABLE_PATTERN,
+			}, nil)
+			assert.Equal(t, expectedObjectPattern, res)
+		})
+	})
+
+	t.Run("pipe statement", func(t *testing.T) {
+		t.Run("ok", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn get_int(){
+					return Array(int, nil)
+				}
+	
+				fn addOne(i %int){
+					$$result = (i + int)
+				}
+	
+				get_int | addOne $
+				return $$result
+			`)
+
+			state.setGlobal("Array", WrapGoFunction(NewArray), GlobalConst)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
 
 		t.Run("$ is an invalid argument in second call", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
@@ -6656,6 +7747,155 @@ func TestSymbolicEval(t *testing.T) {
 				assert.Empty(t, state.errors())
 			})
 
+			t.Run("binary match expression narrows a multivalue to the matched object type", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (a match %{a: int}){
+						var b %{a: int} = a
+						var c %int = a.a
+					} else {
+						var b %{b: str} = a
+					}
+				`)
+
+				state.setGlobal("a", NewMultivalue(
+					NewExactObject2(map[string]Serializable{"a": ANY_INT}),
+					NewExactObject2(map[string]Serializable{"b": ANY_STRING}),
+				), GlobalConst)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+			})
+
+			t.Run("binary match expression does not widen a more specific object type", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (a match %{a: int}){
+						var c %str = a.b
+					}
+				`)
+
+				state.setGlobal("a", NewExactObject2(map[string]Serializable{"a": ANY_INT, "b": ANY_STRING}), GlobalConst)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+			})
+
+			t.Run("binary not-match expression narrows the type of a variable (%int)", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (a not-match %int) {
+						var b %bool = a
+					} else {
+						var b %int = a
+					}
+				`)
+
+				state.setGlobal("a", NewMultivalue(ANY_INT, ANY_BOOL), GlobalConst)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+			})
+
+			t.Run("binary match expression narrows the type of an option", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (a match %--flag=%int) {
+						var b %--flag=%int = a
+					} else {
+						var b %--flag=%str = a
+					}
+				`)
+
+				state.setGlobal("a", NewMultivalue(NewOption("flag", ANY_INT), NewOption("flag", ANY_STRING)), GlobalConst)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+			})
+
+			t.Run("binary keyof expression narrows a string to the keys of an exact object", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (k keyof obj) {
+						var key %| "a" | "b" | "c" = k
+						return k
+					}
+				`)
+
+				state.setGlobal("k", ANY_STRING, GlobalConst)
+				state.setGlobal("obj", NewExactObject2(map[string]Serializable{"a": ANY_INT, "b": ANY_INT, "c": ANY_INT}), GlobalConst)
+
+				returnStmt := parse.FindNode(n, (*parse.ReturnStatement)(nil), nil)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+
+				returnedValue, _ := state.symbolicData.GetMostSpecificNodeValue(returnStmt.Expr)
+				assert.Equal(t, NewMultivalue(NewString("a"), NewString("b"), NewString("c")), returnedValue)
+			})
+
+			t.Run("binary keyof expression keeps the previous narrowing of the string", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (k == "a") {
+						if (k keyof obj) {
+							return k
+						}
+					}
+				`)
+
+				state.setGlobal("k", ANY_STRING, GlobalConst)
+				state.setGlobal("obj", NewExactObject2(map[string]Serializable{"a": ANY_INT, "b": ANY_INT, "c": ANY_INT}), GlobalConst)
+
+				returnStmt := parse.FindNode(n, (*parse.ReturnStatement)(nil), nil)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+
+				returnedValue, _ := state.symbolicData.GetMostSpecificNodeValue(returnStmt.Expr)
+				assert.Equal(t, NewString("a"), returnedValue)
+			})
+
+			t.Run("binary keyof expression narrows a union of strings to the possible keys", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (k keyof obj) {
+						return k
+					}
+				`)
+
+				state.setGlobal("k", NewMultivalue(NewString("a"), NewString("b"), NewString("z")), GlobalConst)
+				state.setGlobal("obj", NewExactObject2(map[string]Serializable{"a": ANY_INT, "b": ANY_INT, "c": ANY_INT}), GlobalConst)
+
+				returnStmt := parse.FindNode(n, (*parse.ReturnStatement)(nil), nil)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+
+				returnedValue, _ := state.symbolicData.GetMostSpecificNodeValue(returnStmt.Expr)
+				assert.Equal(t, NewMultivalue(NewString("a"), NewString("b")), returnedValue)
+			})
+
+			t.Run("binary keyof expression does not narrow if the object is inexact", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (k keyof obj) {
+						return k
+					}
+				`)
+
+				state.setGlobal("k", ANY_STRING, GlobalConst)
+				state.setGlobal("obj", NewInexactObject2(map[string]Serializable{"a": ANY_INT}), GlobalConst)
+
+				returnStmt := parse.FindNode(n, (*parse.ReturnStatement)(nil), nil)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+
+				returnedValue, _ := state.symbolicData.GetMostSpecificNodeValue(returnStmt.Expr)
+				assert.Equal(t, ANY_STRING, returnedValue)
+			})
+
 			t.Run("binary match expression narrows the type of a property (%int)", func(t *testing.T) {
 				n, state := MakeTestStateAndChunk(`
 					if (a.prop match %int) {
@@ -6736,6 +7976,59 @@ func TestSymbolicEval(t *testing.T) {
 				assert.Empty(t, state.errors())
 			})
 
+			t.Run("binary == expression with a constant narrows the variable in both branches", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (a == 5) {
+						x = a
+					} else {
+						y = a
+					}
+				`)
+
+				state.setGlobal("a", NewMultivalue(NewInt(5), NewString("a")), GlobalConst)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+
+				identLiterals := parse.FindNodes(n, (*parse.IdentifierLiteral)(nil), func(n *parse.IdentifierLiteral) bool {
+					return n.Name == "a"
+				})
+
+				consequentValue, _ := state.symbolicData.GetMostSpecificNodeValue(identLiterals[1])
+				assert.Equal(t, NewInt(5), consequentValue)
+
+				alternateValue, _ := state.symbolicData.GetMostSpecificNodeValue(identLiterals[2])
+				assert.Equal(t, NewString("a"), alternateValue)
+			})
+
+			t.Run("binary == expression with a non-constant operand does not remove values in the else branch", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					if (a == b) {
+						x = a
+					} else {
+						y = a
+					}
+				`)
+
+				state.setGlobal("a", NewMultivalue(ANY_INT, ANY_BOOL), GlobalConst)
+				state.setGlobal("b", ANY_INT, GlobalConst)
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+
+				identLiterals := parse.FindNodes(n, (*parse.IdentifierLiteral)(nil), func(n *parse.IdentifierLiteral) bool {
+					return n.Name == "a"
+				})
+
+				consequentValue, _ := state.symbolicData.GetMostSpecificNodeValue(identLiterals[1])
+				assert.Equal(t, ANY_INT, consequentValue)
+
+				alternateValue, _ := state.symbolicData.GetMostSpecificNodeValue(identLiterals[2])
+				assert.Equal(t, NewMultivalue(ANY_INT, ANY_BOOL), alternateValue)
+			})
+
 			t.Run("binary == expression narrows the type of a property (%int)", func(t *testing.T) {
 				n, state := MakeTestStateAndChunk(`
 					if (a.prop == 1) {
@@ -7518,6 +8811,81 @@ func TestSymbolicEval(t *testing.T) {
 
 		})
 
+		t.Run("computed member expression LHS", func(t *testing.T) {
+			t.Run("value assignable to type", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					var o %{a: int} = {a: 1}
+					name = "a"
+					o.(name) = 2
+					return o.a
+				`)
+				res, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+				assert.Equal(t, INT_2, res)
+			})
+
+			t.Run("value not assignable to type", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					var o %{a: int} = {a: 1}
+					o.("a") = "s"
+				`)
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+
+				assignment := parse.FindNode(n, (*parse.Assignment)(nil), nil)
+
+				assert.Equal(t, []SymbolicEvaluationError{
+					makeSymbolicEvalError(assignment, state, fmtNotAssignableToPropOfType(NewString("s"), state.ctx.ResolveNamedPattern("int"))),
+				}, state.errors())
+			})
+
+			t.Run("unknown property name", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					fn f(name str){
+						var o %{a: int} = {a: 1}
+						o.(name) = "s"
+					}
+				`)
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				assert.Empty(t, state.errors())
+			})
+
+			t.Run("property name is not a string", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					var o %{a: int} = {a: 1}
+					o.(1) = 2
+				`)
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+
+				computedMemberExpr := parse.FindNode(n, (*parse.ComputedMemberExpression)(nil), nil)
+
+				assert.Equal(t, []SymbolicEvaluationError{
+					makeSymbolicEvalError(computedMemberExpr.PropertyName, state, fmtComputedPropNameShouldBeAStringNotA(INT_1)),
+				}, state.errors())
+			})
+
+			t.Run("value without properties", func(t *testing.T) {
+				n, state := MakeTestStateAndChunk(`
+					i = 1
+					i.("a") = 2
+				`)
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+
+				assignment := parse.FindNode(n, (*parse.Assignment)(nil), func(n *parse.Assignment, _ bool) bool {
+					_, ok := n.Left.(*parse.ComputedMemberExpression)
+					return ok
+				})
+
+				assert.Equal(t, []SymbolicEvaluationError{
+					makeSymbolicEvalError(assignment, state, FmtCannotAssignPropertyOf(INT_1)),
+				}, state.errors())
+			})
+		})
+
 		t.Run("identifier member expression LHS", func(t *testing.T) {
 			t.Run("value not assignable to type (deep mismatch: object property)", func(t *testing.T) {
 				n, state := MakeTestStateAndChunk(`
@@ -8646,6 +10014,20 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, NewMultivalue(expectedResultFromForStmt, Nil), res)
 		})
 
+		t.Run("single-element int range iteration: the value is known", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				for i, e in 5..5 {
+					return [i, e]
+				}
+			`)
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			expectedResultFromForStmt := NewList(ANY_INT, NewInt(5))
+			assert.Equal(t, NewMultivalue(expectedResultFromForStmt, Nil), res)
+		})
+
 		t.Run("rune range iteration: keys are integers and values are runes", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				for i, r in 'a'..'z' {
@@ -8877,12 +10259,77 @@ func TestSymbolicEval(t *testing.T) {
 
 	t.Run("switch statement", func(t *testing.T) {
 
-		t.Run("error in every block (no default case)", func(t *testing.T) {
+		t.Run("union of two values, only one case and no default case", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				v = int
 				switch v {
-					0 {
-						!"s"
+					"a" {}
+				}
+			`)
+			state.setGlobal("v", NewMultivalue(NewString("a"), NewString("b")), GlobalConst)
+			switchStmt := parse.FindNode(n, (*parse.SwitchStatement)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(switchStmt, state, fmtSwitchStatementDoesNotCoverValues([]Value{NewString("b")})),
+			}, state.warnings())
+			assert.Nil(t, res)
+		})
+
+		t.Run("union of two values, all values are covered", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				switch v {
+					"a" {}
+					"b" {}
+				}
+			`)
+			state.setGlobal("v", NewMultivalue(NewString("a"), NewString("b")), GlobalConst)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+			assert.Nil(t, res)
+		})
+
+		t.Run("union of two values, only one case and a default case", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				switch v {
+					"a" {}
+					defaultcase {}
+				}
+			`)
+			state.setGlobal("v", NewMultivalue(NewString("a"), NewString("b")), GlobalConst)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+			assert.Nil(t, res)
+		})
+
+		t.Run("union of non-concretizable values, only one case and no default case", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				switch v {
+					"a" {}
+				}
+			`)
+			state.setGlobal("v", NewMultivalue(ANY_STRING, ANY_INT), GlobalConst)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+			assert.Nil(t, res)
+		})
+
+		t.Run("error in every block (no default case)", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				v = int
+				switch v {
+					0 {
+						!"s"
 					}
 					int {
 						!"s"
@@ -9117,6 +10564,85 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Nil(t, res)
 		})
 
+		t.Run("group matching case with a regex pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn f(s str){
+					match s {
+						%` + "`" + `^(?P<year>\d{4})-(\d{2})$` + "`" + ` m {
+							return m.year
+						}
+					}
+				}
+			`)
+
+			groupsVariable := parse.FindNode(n, (*parse.IdentifierLiteral)(nil), func(n *parse.IdentifierLiteral, _ bool) bool {
+				return n.Name == "m"
+			})
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			groups, ok := state.symbolicData.GetMostSpecificNodeValue(groupsVariable)
+			if !assert.True(t, ok) {
+				return
+			}
+
+			obj := groups.(*Object)
+			assert.Equal(t, ANY_STRING, obj.Prop("year"))
+			assert.Equal(t, ANY_STRING, obj.Prop("1"))
+			assert.Equal(t, ANY_STRING, obj.Prop("2"))
+		})
+
+		t.Run("group matching case with a regex pattern and a string with a known value", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				match "2024-01" {
+					%` + "`" + `^(?P<year>\d{4})-(?P<month>\d{2})$` + "`" + ` m {
+						return [m.year, m.month]
+					}
+				}
+			`)
+
+			listLit := parse.FindNode(n, (*parse.ListLiteral)(nil), nil)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			list, ok := state.symbolicData.GetMostSpecificNodeValue(listLit)
+			if !assert.True(t, ok) {
+				return
+			}
+			assert.Equal(t, NewList(NewString("2024"), NewString("01")), list)
+		})
+
+		t.Run("narrowing of variable's value in a group matching case", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				fn f(v %| int | path){
+					match v {
+						%/home/{:username} m {
+							var path %path = v
+							return [path, m.username]
+						}
+					}
+				}
+			`)
+			state.ctx.AddNamedPattern("path", &TypePattern{val: ANY_PATH}, false)
+
+			listLit := parse.FindNode(n, (*parse.ListLiteral)(nil), nil)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			//the block should be evaluated with the narrowed value.
+			list, ok := state.symbolicData.GetMostSpecificNodeValue(listLit)
+			if !assert.True(t, ok) {
+				return
+			}
+			assert.Equal(t, NewList(ANY_PATH, ANY_STRING), list)
+		})
+
 		t.Run("narrowing of variable's value (no default case)", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				fn f(v){
@@ -9223,7 +10749,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Equal(t, []SymbolicEvaluationError{
-				makeSymbolicEvalError(spreadElem, state, fmtPatternSpreadInObjectPatternShouldBeAnObjectPatternNot(&ExactValuePattern{value: &Int{hasValue: true, value: 1}})),
+				makeSymbolicEvalError(spreadElem, state, fmtPatternSpreadInObjectPatternShouldBeAnObjectOrRecordPatternNot(&ExactValuePattern{value: &Int{hasValue: true, value: 1}})),
 			}, state.errors())
 			assert.Equal(t, &ObjectPattern{
 				entries: map[string]Pattern{},
@@ -9301,6 +10827,70 @@ func TestSymbolicEval(t *testing.T) {
 			})
 		})
 
+		t.Run("spread exact object pattern in exact object pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{...%{name: %str, otherprops(no)}, otherprops(no)}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+			assert.Equal(t, &ObjectPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				inexact: false,
+			}, res)
+		})
+
+		t.Run("spread exact object pattern in inexact object pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{...%{name: %str, otherprops(no)}}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+			assert.Equal(t, &ObjectPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				inexact: true,
+			}, res)
+		})
+
+		t.Run("spread inexact object pattern in exact object pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{...%{name: %str}, otherprops(no)}
+			`)
+
+			spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(spreadElem, state, SPREAD_INEXACT_OBJ_PATTERN_MAKES_RESULT_INEXACT),
+			}, state.warnings())
+			assert.Equal(t, &ObjectPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				inexact: true,
+			}, res)
+		})
+
+		t.Run("spread object pattern with an optional property", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{...%{name?: %str}}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &ObjectPattern{
+				entries:         map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				optionalEntries: map[string]struct{}{"name": {}},
+				inexact:         true,
+			}, res)
+		})
+
 		t.Run("spread properties should be unique among spread patterns", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				return %{...%{name: %str}, ...%{name: %int}}
@@ -9529,76 +11119,255 @@ func TestSymbolicEval(t *testing.T) {
 				res.(*ObjectPattern).SymbolicValue()
 			})
 		})
-	})
-
-	t.Run("record pattern literal", func(t *testing.T) {
 
-		t.Run("spread pattern that is not an record pattern", func(t *testing.T) {
+		t.Run("spread record pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				pattern rec = #{name: str}
+				return %{...%rec}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &ObjectPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				inexact: true,
+			}, res)
+		})
+
+		t.Run("spread record pattern with an optional entry", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{...#{name?: %str}}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &ObjectPattern{
+				entries:         map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				optionalEntries: map[string]struct{}{"name": {}},
+				inexact:         true,
+			}, res)
+		})
+
+		t.Run("spread exact record pattern in exact object pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{...#{name: %str, otherprops(no)}, otherprops(no)}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Empty(t, state.warnings())
+			assert.Equal(t, &ObjectPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+			}, res)
+		})
+
+		t.Run("spread inexact record pattern in exact object pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{...#{name: %str}, otherprops(no)}
+			`)
+
+			spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(spreadElem, state, SPREAD_INEXACT_REC_PATTERN_MAKES_RESULT_INEXACT),
+			}, state.warnings())
+			assert.Equal(t, &ObjectPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				inexact: true,
+			}, res)
+		})
+	})
+
+	t.Run("record pattern literal", func(t *testing.T) {
+
+		t.Run("spread pattern that is not an record pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{x: #{...1}}
+			`)
+
+			spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(spreadElem, state, fmtPatternSpreadInRecordPatternShouldBeARecordOrObjectPatternNot(&ExactValuePattern{value: &Int{hasValue: true, value: 1}})),
+			}, state.errors())
+			assert.Equal(t, &RecordPattern{
+				entries: map[string]Pattern{},
+				inexact: true,
+			}, res.(*ObjectPattern).entries["x"])
+		})
+
+		t.Run("spread record pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{x: #{...#{}}}
+			`)
+
+			//spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			// assert.Equal(t, []SymbolicEvaluationError{
+			// 	makeSymbolicEvalError(spreadElem, state, CANNOT_SPREAD_OBJ_PATTERN_THAT_IS_INEXACT),
+			// }, state.errors())
+			assert.Equal(t, &RecordPattern{
+				entries: map[string]Pattern{},
+				inexact: true,
+			}, res.(*ObjectPattern).entries["x"])
+		})
+
+		t.Run("spread object pattern matching all objects", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{x: #{...%p}}
+			`)
+
+			state.ctx.AddNamedPattern("p", ANY_RECORD_PATTERN, false)
+
+			spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(spreadElem, state, CANNOT_SPREAD_REC_PATTERN_THAT_MATCHES_ANY_RECORD),
+			}, state.errors())
+			assert.Equal(t, &RecordPattern{
+				entries: map[string]Pattern{},
+				inexact: true,
+			}, res.(*ObjectPattern).entries["x"])
+		})
+
+		t.Run("spread valid record pattern", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{x: #{...#{name: %str}}}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &RecordPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				inexact: true,
+			}, res.(*ObjectPattern).entries["x"])
+		})
+
+		t.Run("spread object pattern with entries matching immutable values", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				pattern obj = {name: str}
+				return %{x: #{...%obj}}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &RecordPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				inexact: true,
+			}, res.(*ObjectPattern).entries["x"])
+		})
+
+		t.Run("spread object pattern with an entry matching mutable values", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				pattern obj = {name: str, list: []int}
+				return %{x: #{...%obj}}
+			`)
+
+			spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(spreadElem, state, fmtEntryOfObjectPatternSpreadInRecordPatternShouldMatchOnlyImmutableValues("list")),
+			}, state.errors())
+			assert.Equal(t, &RecordPattern{
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				inexact: true,
+			}, res.(*ObjectPattern).entries["x"])
+		})
+
+		t.Run("spread object pattern with an optional entry", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %{x: #{...%{name?: %str}}}
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &RecordPattern{
+				entries:         map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				optionalEntries: map[string]struct{}{"name": {}},
+				inexact:         true,
+			}, res.(*ObjectPattern).entries["x"])
+		})
+
+		t.Run("spread record pattern with an optional entry", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				return %{x: #{...1}}
+				return %{x: #{...#{name?: %str}}}
 			`)
 
-			spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
-
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
-			assert.Equal(t, []SymbolicEvaluationError{
-				makeSymbolicEvalError(spreadElem, state, fmtPatternSpreadInRecordPatternShouldBeAnRecordPatternNot(&ExactValuePattern{value: &Int{hasValue: true, value: 1}})),
-			}, state.errors())
+			assert.Empty(t, state.errors())
 			assert.Equal(t, &RecordPattern{
-				entries: map[string]Pattern{},
-				inexact: true,
+				entries:         map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
+				optionalEntries: map[string]struct{}{"name": {}},
+				inexact:         true,
 			}, res.(*ObjectPattern).entries["x"])
 		})
 
-		t.Run("spread record pattern", func(t *testing.T) {
+		t.Run("spread exact object pattern in exact record pattern", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				return %{x: #{...#{}}}
+				return %{x: #{...%{name: %str, otherprops(no)}, otherprops(no)}}
 			`)
 
-			//spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
-
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-
-			// assert.Equal(t, []SymbolicEvaluationError{
-			// 	makeSymbolicEvalError(spreadElem, state, CANNOT_SPREAD_OBJ_PATTERN_THAT_IS_INEXACT),
-			// }, state.errors())
+			assert.Empty(t, state.warnings())
 			assert.Equal(t, &RecordPattern{
-				entries: map[string]Pattern{},
-				inexact: true,
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
 			}, res.(*ObjectPattern).entries["x"])
 		})
 
-		t.Run("spread object pattern matching all objects", func(t *testing.T) {
+		t.Run("spread inexact object pattern in exact record pattern", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				return %{x: #{...%p}}
+				return %{x: #{...%{name: %str}, otherprops(no)}}
 			`)
 
-			state.ctx.AddNamedPattern("p", ANY_RECORD_PATTERN, false)
-
 			spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
 
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
-			assert.Equal(t, []SymbolicEvaluationError{
-				makeSymbolicEvalError(spreadElem, state, CANNOT_SPREAD_REC_PATTERN_THAT_MATCHES_ANY_RECORD),
-			}, state.errors())
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(spreadElem, state, SPREAD_INEXACT_OBJ_PATTERN_MAKES_RESULT_INEXACT),
+			}, state.warnings())
 			assert.Equal(t, &RecordPattern{
-				entries: map[string]Pattern{},
+				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
 				inexact: true,
 			}, res.(*ObjectPattern).entries["x"])
 		})
 
-		t.Run("spread valid record pattern", func(t *testing.T) {
+		t.Run("spread inexact record pattern in exact record pattern", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				return %{x: #{...#{name: %str}}}
+				return %{x: #{...#{name: %str}, otherprops(no)}}
 			`)
 
+			spreadElem := parse.FindNode(n, (*parse.PatternPropertySpreadElement)(nil), nil)
+
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarning(spreadElem, state, SPREAD_INEXACT_REC_PATTERN_MAKES_RESULT_INEXACT),
+			}, state.warnings())
 			assert.Equal(t, &RecordPattern{
 				entries: map[string]Pattern{"name": state.ctx.ResolveNamedPattern("str")},
 				inexact: true,
@@ -10102,6 +11871,65 @@ func TestSymbolicEval(t *testing.T) {
 		assert.Equal(t, NewSequenceStringPattern(complexStringPatternPiece, &parse.Chunk{}), res)
 	})
 
+	t.Run("pattern call", func(t *testing.T) {
+		addPatternWithArity := func(state *State) {
+			pattern := &TypePattern{
+				val: ANY_INT,
+				call: func(ctx *Context, values []Value) (Pattern, error) {
+					return NewIntRangePattern(values[0].(*IntRange)), nil
+				},
+			}
+			pattern.SetCallArity(CallArity{Min: 1, Max: 1})
+			state.ctx.AddNamedPattern("ranged", pattern, false)
+		}
+
+		t.Run("expected number of arguments", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %ranged(0..1)
+			`)
+			addPatternWithArity(state)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.IsType(t, (*IntRangePattern)(nil), res)
+		})
+
+		t.Run("too many arguments", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %ranged(0..1, 1, 2)
+			`)
+			addPatternWithArity(state)
+
+			patternCallExpr := parse.FindNode(n, (*parse.PatternCallExpression)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(patternCallExpr.Arguments[1], state, fmtTooManyArgs(3, 1)),
+				makeSymbolicEvalError(patternCallExpr.Arguments[2], state, fmtTooManyArgs(3, 1)),
+			}, state.errors())
+			assert.Equal(t, ANY_PATTERN, res)
+		})
+
+		t.Run("not enough arguments", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %ranged()
+			`)
+			addPatternWithArity(state)
+
+			patternCallExpr := parse.FindNode(n, (*parse.PatternCallExpression)(nil), nil)
+			closingParenSpan := parse.NodeSpan{Start: patternCallExpr.Span.End - 1, End: patternCallExpr.Span.End}
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalErrorWithSpan(closingParenSpan, state, fmtNotEnoughArgs(0, 1)),
+			}, state.errors())
+			assert.Equal(t, ANY_PATTERN, res)
+		})
+	})
+
 	t.Run("pattern conversion expressions", func(t *testing.T) {
 		t.Run("base case", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
@@ -10135,8 +11963,27 @@ func TestSymbolicEval(t *testing.T) {
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
 
-			runTimeValue := NewRunTimeValue(AsSerializableChecked(NewMultivalue(INT_1, INT_2)))
-			expectedPattern := utils.Must(NewExactValuePattern(AsSerializableChecked(runTimeValue)))
+			expectedPattern := utils.Must(NewUnionPattern([]Pattern{
+				utils.Must(NewExactValuePattern(INT_1)),
+				utils.Must(NewExactValuePattern(INT_2)),
+			}, false))
+			assert.Equal(t, expectedPattern, res)
+		})
+
+		t.Run("multivalue of an integer and a string", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return %(v)
+			`)
+			state.setGlobal("v", NewMultivalue(INT_1, NewString("1")), GlobalVar)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			expectedPattern := utils.Must(NewUnionPattern([]Pattern{
+				utils.Must(NewExactValuePattern(INT_1)),
+				NewExactStringPatternWithConcreteValue(NewString("1")),
+			}, false))
 			assert.Equal(t, expectedPattern, res)
 		})
 
@@ -11002,7 +12849,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TEST_SUITE, res)
+			assert.Equal(t, NewTestSuite(NewString("name")), res)
 		})
 
 		t.Run("tests suite should inherit patterns defined by the parent state", func(t *testing.T) {
@@ -11016,7 +12863,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TEST_SUITE, res)
+			assert.Equal(t, NewTestSuite(NewString("name")), res)
 		})
 
 		t.Run("meta value should either be a string or a record: string", func(t *testing.T) {
@@ -11025,7 +12872,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TEST_SUITE, res)
+			assert.Equal(t, NewTestSuite(NewString("my test case")), res)
 		})
 
 		t.Run("meta value should either be a string or a record: record", func(t *testing.T) {
@@ -11050,13 +12897,37 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, ANY_TEST_SUITE, res)
 		})
 
+		t.Run("name property", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				item = testsuite({name: "my test"}) {}
+				return item.name
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewString("my test"), res)
+		})
+
+		t.Run("name property: no name", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				item = testsuite({}) {}
+				return item.name
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, EMPTY_STRING, res)
+		})
+
 		t.Run("name value in meta should be a string: string", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`testsuite({name: "test"}) {}`)
 
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TEST_SUITE, res)
+			assert.Equal(t, NewTestSuite(NewString("test")), res)
 		})
 
 		t.Run("name value in meta should be a string: integer", func(t *testing.T) {
@@ -11223,7 +13094,7 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, []SymbolicEvaluationError{
 				makeSymbolicEvalError(binExpr.Right, state, fmtRightOperandOfBinaryShouldBe(parse.Add, "int", "true")),
 			}, state.errors())
-			assert.Equal(t, ANY_TEST_SUITE, res)
+			assert.Equal(t, NewTestSuite(NewString("name")), res)
 		})
 	})
 
@@ -11234,7 +13105,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TEST_CASE, res)
+			assert.Equal(t, NewTestCase(NewString("name")), res)
 		})
 
 		t.Run("meta value should either be a string or a record: string", func(t *testing.T) {
@@ -11243,7 +13114,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TEST_CASE, res)
+			assert.Equal(t, NewTestCase(NewString("my test case")), res)
 		})
 
 		t.Run("meta value should either be a string or a record: record", func(t *testing.T) {
@@ -11268,13 +13139,50 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, ANY_TEST_CASE, res)
 		})
 
+		t.Run("name property", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				item = testcase({name: "my test"}) {}
+				return item.name
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewString("my test"), res)
+		})
+
+		t.Run("name property: no name", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				item = testcase({}) {}
+				return item.name
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, EMPTY_STRING, res)
+		})
+
+		t.Run("run method", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				item = testcase {}
+				assign lthread err = item.run()
+				return lthread
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_LTHREAD, res)
+		})
+
 		t.Run("name value in meta should be a string: string", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`testcase({name: "my test"}) {}`)
 
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TEST_CASE, res)
+			assert.Equal(t, NewTestCase(NewString("my test")), res)
 		})
 
 		t.Run("name value in meta should be a string: integer", func(t *testing.T) {
@@ -11350,7 +13258,7 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, []SymbolicEvaluationError{
 				makeSymbolicEvalError(binExpr.Right, state, fmtRightOperandOfBinaryShouldBe(parse.Add, "int", "true")),
 			}, state.errors())
-			assert.Equal(t, ANY_TEST_CASE, res)
+			assert.Equal(t, NewTestCase(NewString("name")), res)
 		})
 
 		t.Run("a __test global with a program property should be defined within the testcase", func(t *testing.T) {
@@ -11366,6 +13274,30 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, ANY_TEST_CASE, res)
 		})
 
+		t.Run("accessing a valid and an invalid property of __test", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				testcase {
+					__test.program
+					__test.programs
+				}
+			`)
+
+			memberExprs := parse.FindNodes(n, (*parse.IdentifierMemberExpression)(nil), nil)
+			validMemberExpr, invalidMemberExpr := memberExprs[0], memberExprs[1]
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(invalidMemberExpr, state, fmtPropOfDoesNotExist("programs", ANY_CURRENT_TEST, "program")),
+			}, state.errors())
+			assert.Equal(t, ANY_TEST_CASE, res)
+
+			programValue, ok := state.symbolicData.GetMostSpecificNodeValue(validMemberExpr)
+			if assert.True(t, ok) {
+				assert.Equal(t, ANY_TESTED_PROGRAM_OR_NIL, programValue)
+			}
+		})
+
 		t.Run("if the main-db-schema and main-db-migrations properties are present __test.program.dbs.main should be defined", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				testcase({
@@ -11691,7 +13623,90 @@ func TestSymbolicEval(t *testing.T) {
 
 	})
 
+	t.Run("synchronized block", func(t *testing.T) {
+		t.Run("immutable value", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				a = 1
+				synchronized a {}
+			`)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+
+		t.Run("two non-sharable values", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				a = [1]
+				b = [2]
+				synchronized a b {
+					c
+				}
+			`)
+			syncBlock := parse.FindNode(n, (*parse.SynchronizedBlockStatement)(nil), nil)
+			ident := parse.FindNode(n, (*parse.IdentifierLiteral)(nil), func(n *parse.IdentifierLiteral, isUnique bool) bool {
+				return n.Name == "c"
+			})
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+
+			values := []Value{NewList(INT_1), NewList(INT_2)}
+
+			//the block should still be evaluated.
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(syncBlock, state, fmtSynchronizedValuesShouldBeSharableOrImmutable(values)),
+				makeSymbolicEvalError(ident, state, fmtVarIsNotDeclared("c")),
+			}, state.errors())
+		})
+	})
+
 	t.Run("spawn expression", func(t *testing.T) {
+		t.Run("globals section: key list referencing an undefined global", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return go {globals: .{a, b}} do {}
+			`)
+			state.setGlobal("a", INT_1, GlobalVar)
+			keyList := parse.FindNode(n, (*parse.KeyListExpression)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			warnings := state.warnings()
+			assert.Contains(t, warnings, makeSymbolicEvalWarning(keyList.Keys[1], state, fmtGlobalPassedToLThreadIsNotDefined("b")))
+			assert.NotContains(t, warnings, makeSymbolicEvalWarning(keyList.Keys[0], state, fmtGlobalPassedToLThreadIsNotDefined("a")))
+			assert.IsType(t, ANY_LTHREAD, res)
+		})
+
+		t.Run("permission to create lthreads has been dropped", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				go {globals: .{}} do {}
+				drop-perms {
+					create: {threads: {}}
+				}
+				return go {globals: .{}} do {}
+			`)
+			state.ctx.isolatedConcreteContext = testConcreteContext{
+				Context: context.Background(),
+				grantedPermissionTypes: map[permkind.InternalPermissionTypename]permkind.PermissionKind{
+					permkind.LTHREAD_PERM_TYPENAME: permkind.Create,
+				},
+			}
+
+			spawnExprs := parse.FindNodes(n, (*parse.SpawnExpression)(nil), nil)
+			secondSpawnExpr := spawnExprs[1]
+			warningSpan := parse.NodeSpan{Start: secondSpawnExpr.Span.Start, End: secondSpawnExpr.Span.Start + 2}
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, []SymbolicEvaluationWarning{
+				makeSymbolicEvalWarningWithSpan(warningSpan, state, POSSIBLE_MISSING_PERM_TO_CREATE_A_LTHREAD),
+			}, state.warnings())
+			assert.IsType(t, ANY_LTHREAD, res)
+		})
+
 		t.Run("call expression: user defined function", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				fn f(){ }
@@ -11959,6 +13974,22 @@ func TestSymbolicEval(t *testing.T) {
 		})
 	})
 
+	t.Run("yield statement", func(t *testing.T) {
+		t.Run("in an embedded module", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				lthread = go {globals: .{}} do {
+					if true {
+						yield 1
+					}
+				}
+			`)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+	})
+
 	t.Run("reception handler expression", func(t *testing.T) {
 		n, state := MakeTestStateAndChunk(`
 			{
@@ -11992,7 +14023,18 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_MAPPING, res)
+			assert.Equal(t, &Mapping{keyType: NewMultivalue(INT_0, INT_1), valueType: ANY}, res)
+		})
+
+		t.Run("simple keys", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				Mapping { "a" => 1  /a => 2  %int => 3 }
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &Mapping{keyType: NewMultivalue(NewString("a"), NewPath("/a"), ANY_INT), valueType: NewMultivalue(INT_1, INT_2, INT_3)}, res)
 		})
 
 		t.Run("key variable & group matching variable should be accessible in right side", func(t *testing.T) {
@@ -12003,7 +14045,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_MAPPING, res)
+			assert.Equal(t, &Mapping{keyType: ANY_PATH, valueType: NewList(ANY_PATH, NewAnyObject())}, res)
 		})
 
 		t.Run("key variable should be accessible in right side and have right type", func(t *testing.T) {
@@ -12014,7 +14056,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_MAPPING, res)
+			assert.Equal(t, &Mapping{keyType: ANY_INT, valueType: ANY_INT}, res)
 		})
 
 		t.Run("key variable should be accessible in right side and have right type: case pattern key", func(t *testing.T) {
@@ -12025,9 +14067,32 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_MAPPING, res)
+			assert.Equal(t, &Mapping{keyType: ANY_INT, valueType: ANY_INT}, res)
+		})
+
+		t.Run("computed values should have the type of the entry values", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				m = Mapping { 0 => 1  n %int => (n + 1) }
+				return m.compute(0)
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
 		})
 
+		t.Run("computed values should have the type of the entry values: static entries", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				m = Mapping { 0 => 1  1 => 2 }
+				return m.compute(0)
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewMultivalue(INT_1, INT_2), res)
+		})
 	})
 	t.Run("treedata literal", func(t *testing.T) {
 
@@ -12039,7 +14104,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.Equal(t, &Treedata{nodeValue: NewString("root")}, res)
 		})
 
 		t.Run("single child", func(t *testing.T) {
@@ -12050,7 +14115,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.Equal(t, &Treedata{nodeValue: NewMultivalue(NewString("root"), NewString("child"))}, res)
 		})
 
 		//TODO: properly check errors
@@ -12063,7 +14128,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.NotEmpty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.Equal(t, &Treedata{nodeValue: ANY_SERIALIZABLE}, res)
 		})
 
 		t.Run("immutable non-serializable value as root", func(t *testing.T) {
@@ -12074,7 +14139,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.NotEmpty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.Equal(t, &Treedata{nodeValue: ANY_SERIALIZABLE}, res)
 		})
 
 		t.Run("mutable value as child", func(t *testing.T) {
@@ -12085,7 +14150,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.NotEmpty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.Equal(t, &Treedata{nodeValue: ANY_SERIALIZABLE}, res)
 		})
 
 		t.Run("immutable non-serializable value as child", func(t *testing.T) {
@@ -12096,7 +14161,7 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.NotEmpty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.Equal(t, &Treedata{nodeValue: ANY_SERIALIZABLE}, res)
 		})
 
 		t.Run("treedata pair with a mutable key", func(t *testing.T) {
@@ -12107,42 +14172,70 @@ func TestSymbolicEval(t *testing.T) {
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.NotEmpty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.IsType(t, (*Treedata)(nil), res)
+		})
+
+		t.Run("treedata pair with an immutable non-serializable key", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				suite = testsuite {}
+				return treedata "root" { suite: 1 }
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, state.errors())
+			assert.IsType(t, (*Treedata)(nil), res)
+		})
+
+		t.Run("treedata pair with a mutable value", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				treedata "root" { 1: {} }
+			`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.NotEmpty(t, state.errors())
+			assert.IsType(t, (*Treedata)(nil), res)
 		})
 
-		t.Run("treedata pair with an immutable non-serializable key", func(t *testing.T) {
+		t.Run("treedata pair with an immutable non-serializable value", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
 				suite = testsuite {}
-				return treedata "root" { suite: 1 }
+				return treedata "root" { 1: suite }
 			`)
 
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
 			assert.NotEmpty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.IsType(t, (*Treedata)(nil), res)
 		})
 
-		t.Run("treedata pair with a mutable value", func(t *testing.T) {
+		t.Run("walking a treedata with known values", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				treedata "root" { 1: {} }
+				data = treedata 1 { 2 { 3 } }
+				walk data entry {
+					return entry
+				}
 			`)
 
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
-			assert.NotEmpty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewMultivalue(INT_1, INT_2, INT_3), res)
 		})
 
-		t.Run("treedata pair with an immutable non-serializable value", func(t *testing.T) {
+		t.Run("walking a treedata with a pair", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`
-				suite = testsuite {}
-				return treedata "root" { 1: suite }
+				data = treedata "root" { "a": 1 }
+				walk data entry {
+					return entry
+				}
 			`)
 
 			res, err := symbolicEval(n, state)
 			assert.NoError(t, err)
-			assert.NotEmpty(t, state.errors())
-			assert.Equal(t, ANY_TREEDATA, res)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewMultivalue(NewString("root"), NewOrderedPair(NewString("a"), INT_1)), res)
 		})
 	})
 
@@ -12161,7 +14254,7 @@ func TestSymbolicEval(t *testing.T) {
 				makeSymbolicEvalError(computeExpr.Arg, state, INVALID_KEY_IN_COMPUTE_EXPRESSION_ONLY_SIMPLE_VALUE_ARE_SUPPORTED),
 			}, state.errors())
 
-			assert.Equal(t, ANY_MAPPING, res)
+			assert.Equal(t, &Mapping{keyType: INT_0, valueType: ANY}, res)
 		})
 	})
 	t.Run("concatenation expression", func(t *testing.T) {
@@ -12299,6 +14392,50 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, expectedFn, res)
 		})
 
+		t.Run("two lists with known elements", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`concat [1] [2, 3]`)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, NewList(NewInt(1), NewInt(2), NewInt(3)), res)
+		})
+
+		t.Run("list with known elements and list with unknown elements", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				return fn(b %str_list){
+					return concat [1] b
+				}`,
+			)
+			state.ctx.AddNamedPattern("str_list", &TypePattern{val: NewListOf(ANY_STRING)}, false)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+
+			fnExpr := n.Statements[0].(*parse.ReturnStatement).Expr
+			expectedFn := &InoxFunction{
+				node:           fnExpr,
+				nodeChunk:      n,
+				parameters:     []Value{NewListOf(ANY_STRING)},
+				parameterNames: []string{"b"},
+				result:         NewListOf(AsSerializableChecked(NewMultivalue(ANY_STRING, NewInt(1)))),
+			}
+			assert.Equal(t, expectedFn, res)
+		})
+
+		t.Run("list followed by a tuple", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`concat [1] #[2]`)
+			tupleLit := parse.FindNode(n, (*parse.TupleLiteral)(nil), nil)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(tupleLit, state, "list concatenation: invalid element of type *symbolic.Tuple"),
+			}, state.errors())
+			assert.Equal(t, NewList(NewInt(1)), res)
+		})
+
 		t.Run("spread string list", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`concat ...["a"]`)
 
@@ -12399,6 +14536,23 @@ func TestSymbolicEval(t *testing.T) {
 			assert.Equal(t, ANY_CHECKED_STRING, res)
 		})
 
+		t.Run("leading pattern does not exist in the namespace", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(replace(`
+				pnamespace sql. = {int: %str( '0'..'9'+ )}
+				unsanitized_id = "5"
+				return %sql.stmt|SELECT * FROM users WHERE id = ${int:$unsanitized_id}|
+			`))
+
+			templateLit := n.Statements[2].(*parse.ReturnStatement).Expr.(*parse.StringTemplateLiteral)
+
+			res, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(templateLit, state, fmtCannotInterpolateMemberOfPatternNamespaceDoesNotExist("stmt", "sql")),
+			}, state.errors())
+			assert.Equal(t, ANY_CHECKED_STRING, res)
+		})
+
 		t.Run("interpolation pattern does not exist", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(replace(`
 				pnamespace sql. = {stmt: %str( %|.*| )}
@@ -12520,6 +14674,49 @@ func TestSymbolicEval(t *testing.T) {
 
 	t.Run("XML expression", func(t *testing.T) {
 
+		t.Run("iterating the children of an element", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				for child in elem.children {
+					var c %str = child
+				}
+			`)
+			state.setGlobal("elem", NewXmlElement("div", nil, []Value{NewString("a"), ANY_STRING}), GlobalConst)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+
+		t.Run("iterating the children of an element: children of several types", func(t *testing.T) {
+			isWritable := extData.IsWritable
+			defer func() {
+				extData.IsWritable = isWritable
+			}()
+			extData.IsWritable = func(v any) bool {
+				return false
+			}
+
+			n, state := MakeTestStateAndChunk(`
+				for child in elem.children {
+					var c %str = child
+				}
+			`)
+			span := NewXmlElement("span", nil, nil)
+			state.setGlobal("elem", NewXmlElement("div", nil, []Value{ANY_INT, span}), GlobalConst)
+			childIdent := parse.FindNode(n, (*parse.IdentifierLiteral)(nil), func(n *parse.IdentifierLiteral, isUnique bool) bool {
+				return n.Name == "child"
+			})
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+
+			childValue, ok := state.symbolicData.GetMostSpecificNodeValue(childIdent)
+			if assert.True(t, ok) {
+				assert.Equal(t, NewMultivalue(ANY_INT, span), childValue)
+			}
+			assert.NotEmpty(t, state.errors())
+		})
+
 		t.Run("namespace not a record", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`html<div></div>`)
 			state.setGlobal("html", Nil, GlobalConst)
@@ -12734,6 +14931,69 @@ func TestSymbolicEval(t *testing.T) {
 			}, res)
 		})
 
+		t.Run("attribute with checking", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`return html<div a="a"></div>`)
+			goFn := func(ctx *Context, elem *XMLElement) *XMLElement {
+				return elem
+			}
+
+			state.setGlobal("html", NewNamespace(map[string]Value{
+				FROM_XML_FACTORY_NAME: WrapGoFunction(goFn),
+			}), GlobalConst)
+
+			RegisterXMLAttributeCheckingFunction(goFn, func(n parse.Node, elementName, attrName string, value Value) (errorMsg string) {
+				if _, ok := value.(StringLike); !ok {
+					return "attribute values should be strings"
+				}
+				return ""
+			})
+			defer UnregisterXMLCheckingFunction(goFn)
+
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, &XMLElement{
+				name:       "div",
+				attributes: map[string]Value{"a": NewString("a")},
+				children:   []Value{ANY_STRING},
+			}, res)
+		})
+
+		t.Run("attribute with checking: unexpected value", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`return html<div a=1></div>`)
+			goFn := func(ctx *Context, elem *XMLElement) *XMLElement {
+				return elem
+			}
+
+			state.setGlobal("html", NewNamespace(map[string]Value{
+				FROM_XML_FACTORY_NAME: WrapGoFunction(goFn),
+			}), GlobalConst)
+
+			RegisterXMLAttributeCheckingFunction(goFn, func(n parse.Node, elementName, attrName string, value Value) (errorMsg string) {
+				if _, ok := value.(StringLike); !ok {
+					return "attribute values should be strings"
+				}
+				return ""
+			})
+			defer UnregisterXMLCheckingFunction(goFn)
+
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Equal(t, &XMLElement{
+				name:       "div",
+				attributes: map[string]Value{"a": INT_1},
+				children:   []Value{ANY_STRING},
+			}, res)
+
+			intLit := parse.FindNode(n, (*parse.IntLiteral)(nil), nil)
+
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(intLit, state, "attribute values should be strings"),
+			}, state.errors())
+		})
+
 		t.Run("error during factory call", func(t *testing.T) {
 			n, state := MakeTestStateAndChunk(`return html<div></div>`)
 			state.setGlobal("html", NewNamespace(map[string]Value{
@@ -12865,6 +15125,62 @@ func TestSymbolicEval(t *testing.T) {
 
 			assert.NoError(t, err)
 			assert.Empty(t, state.errors())
+			assert.Equal(t, ANY_INT, res)
+		})
+
+		t.Run("imported module returning an object", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				manifest {}
+				import lib ./lib.ix {}
+				return lib.a
+			`)
+			importStmt := parse.FindNode(n, (*parse.ImportStatement)(nil), nil)
+			state.Module.directlyImportedModules = map[*parse.ImportStatement]*Module{
+				importStmt: {
+					mainChunk: utils.Must(parse.ParseChunkSource(parse.SourceFile{
+						NameString:  "/lib.ix",
+						Resource:    "/lib.ix",
+						ResourceDir: "/",
+						CodeString:  "manifest {}\nreturn {a: 1, b: \"b\"}",
+					})),
+				},
+			}
+
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+			assert.Equal(t, INT_1, res)
+
+			ident := importStmt.Identifier
+			value, ok := state.symbolicData.GetMostSpecificNodeValue(ident)
+			if assert.True(t, ok) && assert.IsType(t, (*Object)(nil), value) {
+				assert.Equal(t, NewString("b"), value.(*Object).Prop("b"))
+			}
+		})
+
+		t.Run("accessing a property that does not exist on the result of an imported module", func(t *testing.T) {
+			n, state := MakeTestStateAndChunk(`
+				manifest {}
+				import lib ./lib.ix {}
+				return lib.c
+			`)
+			importStmt := parse.FindNode(n, (*parse.ImportStatement)(nil), nil)
+			state.Module.directlyImportedModules = map[*parse.ImportStatement]*Module{
+				importStmt: {
+					mainChunk: utils.Must(parse.ParseChunkSource(parse.SourceFile{
+						NameString:  "/lib.ix",
+						Resource:    "/lib.ix",
+						ResourceDir: "/",
+						CodeString:  "manifest {}\nreturn {a: 1}",
+					})),
+				},
+			}
+
+			res, err := symbolicEval(n, state)
+
+			assert.NoError(t, err)
+			assert.Len(t, state.errors(), 1)
 			assert.Equal(t, ANY, res)
 		})
 
@@ -12987,6 +15303,74 @@ func TestSymbolicEval(t *testing.T) {
 
 			assert.Equal(t, ANY, res)
 		})
+
+		t.Run("unchanged imported module should not be re-checked if the previous data is provided", func(t *testing.T) {
+			callCount := 0
+			baseGlobals := map[string]Value{
+				"f": &GoFunction{
+					fn: func(ctx *Context) *Int {
+						callCount++
+						return ANY_INT
+					},
+				},
+			}
+
+			importedModule := &Module{
+				mainChunk: utils.Must(parse.ParseChunkSource(parse.SourceFile{
+					NameString:  "/lib.ix",
+					Resource:    "/lib.ix",
+					ResourceDir: "/",
+					CodeString:  "manifest {}\nf()\n(1 + \"a\")",
+				})),
+			}
+
+			check := func(previousData *Data) *State {
+				n, state := MakeTestStateAndChunk(`
+					manifest {}
+					import lib ./lib.ix {}
+					return lib
+				`)
+				importStmt := parse.FindNode(n, (*parse.ImportStatement)(nil), nil)
+				state.Module.directlyImportedModules = map[*parse.ImportStatement]*Module{importStmt: importedModule}
+				state.baseGlobals = baseGlobals
+				state.previousSymbolicData = previousData
+
+				_, err := symbolicEval(n, state)
+				assert.NoError(t, err)
+				return state
+			}
+
+			firstState := check(nil)
+			if !assert.Equal(t, 1, callCount) {
+				return
+			}
+			assert.Len(t, firstState.errors(), 1)
+
+			//the main chunk is re-parsed but the imported module is unchanged.
+			secondState := check(firstState.symbolicData)
+			assert.Equal(t, 1, callCount)
+			assert.Equal(t, firstState.errors(), secondState.errors())
+
+			callExpr := parse.FindNode(importedModule.mainChunk.Node, (*parse.CallExpression)(nil), nil)
+			value, ok := secondState.symbolicData.GetMostSpecificNodeValue(callExpr)
+			if assert.True(t, ok) {
+				assert.Equal(t, ANY_INT, value)
+			}
+
+			//the imported module is changed.
+			importedModule = &Module{
+				mainChunk: utils.Must(parse.ParseChunkSource(parse.SourceFile{
+					NameString:  "/lib.ix",
+					Resource:    "/lib.ix",
+					ResourceDir: "/",
+					CodeString:  "manifest {}\nf()",
+				})),
+			}
+
+			thirdState := check(secondState.symbolicData)
+			assert.Equal(t, 2, callCount)
+			assert.Empty(t, thirdState.errors())
+		})
 	})
 
 	t.Run("inclusion import statement ", func(t *testing.T) {
@@ -13158,6 +15542,48 @@ func TestSymbolicEval(t *testing.T) {
 			}, state.errors())
 		})
 
+		t.Run("two extensions of the same pattern should not define the same property", func(t *testing.T) {
+			n, state := MakeTestStateAndChunks(`
+				pattern p = {a: 1}
+
+				extend p {
+					f: fn(){}
+				}
+
+				extend p {
+					f: fn(){}
+				}
+			`, nil)
+
+			objProp := parse.FindNode(n.Statements[2], (*parse.ObjectProperty)(nil), nil)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+
+			assert.Equal(t, []SymbolicEvaluationError{
+				makeSymbolicEvalError(objProp.Key, state, fmtAnotherExtensionAlreadyDefinesAnXProperty("f")),
+			}, state.errors())
+		})
+
+		t.Run("extensions of different patterns can define the same property", func(t *testing.T) {
+			n, state := MakeTestStateAndChunks(`
+				pattern p = {a: 1}
+				pattern q = {b: 1}
+
+				extend p {
+					f: fn(){}
+				}
+
+				extend q {
+					f: fn(){}
+				}
+			`, nil)
+
+			_, err := symbolicEval(n, state)
+			assert.NoError(t, err)
+			assert.Empty(t, state.errors())
+		})
+
 		t.Run("properties of the extension object should not be implicit or index-like", func(t *testing.T) {
 			n, state := MakeTestStateAndChunks(`
 				pattern p = {a: 1}
@@ -13678,7 +16104,7 @@ func TestSymbolicEval(t *testing.T) {
 				assert.NoError(t, err)
 				assert.Empty(t, state.errors())
 				assert.Equal(t, NewInexactObject(map[string]Serializable{
-					"list": NewListOf(INT_1),
+					"list": &List{generalElement: INT_1, inferredElement: true},
 				}, nil, map[string]Pattern{
 					"list": NewListPatternOf(&TypePattern{val: ANY_SERIALIZABLE}),
 				}), res)
@@ -77,7 +77,7 @@ type ExternalData struct {
 	AppendPathSegmentToURLPattern           func(urlPattern, segment string) string
 	CheckDatabaseSchema                     func(objectPattern any) error
 	GetTopLevelEntitiesMigrationOperations  func(concreteCtx context.Context, current, next any) ([]MigrationOp, error)
-	EstimatePermissionsFromListingNode      func(n *parse.ObjectLiteral) (any, error)
+	EstimateLThreadPermissions              func(n *parse.SpawnExpression, parentCtx ConcreteContext) (any, error)
 	CreateConcreteContext                   func(permissions any) ConcreteContext
 
 	ConcreteValueFactories ConcreteValueFactories
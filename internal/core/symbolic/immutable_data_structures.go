@@ -200,7 +200,10 @@ func (t *Tuple) WidestOfType() Value {
 
 func (t *Tuple) slice(start, end *Int) Sequence {
 	if t.HasKnownLen() {
-		return &Tuple{generalElement: ANY_SERIALIZABLE}
+		if startIndex, endIndex, ok := getKnownSliceBounds(start, end, t.KnownLen()); ok {
+			return NewTuple(t.elements[startIndex:endIndex]...)
+		}
+		return &Tuple{generalElement: AsSerializableChecked(t.Element())}
 	}
 	return &Tuple{
 		generalElement: t.generalElement,
@@ -2,6 +2,7 @@ package symbolic
 
 import (
 	"bytes"
+	"slices"
 	"strconv"
 
 	pprint "github.com/inoxlang/inox/internal/prettyprint"
@@ -9,7 +10,8 @@ import (
 )
 
 var (
-	LIST_APPEND_PARAM_NAMES = []string{"values"}
+	LIST_APPEND_PARAM_NAMES        = []string{"values"}
+	LIST_INSERT_SORTED_PARAM_NAMES = []string{"value", "order"}
 
 	LIST_OF_SERIALIZABLES = NewListOf(ANY_SERIALIZABLE)
 )
@@ -190,10 +192,17 @@ func (*Array) WidestOfType() Value {
 
 // A List represents a symbolic List.
 type List struct {
-	elements       []Serializable
+	elements []Serializable
+
+	//if .elements is not nil .generalElement is only set for empty lists having an expected element type,
+	//see NewEmptyListOf.
 	generalElement Serializable
 	readonly       bool
 
+	//true if generalElement has been inferred from the elements added to a list with no elements,
+	//in this case generalElement does not constrain the elements that can be added.
+	inferredElement bool
+
 	SerializableMixin
 	ClonableSerializableMixin
 	UnassignablePropsMixin
@@ -216,6 +225,11 @@ func NewListOf(generalElement Serializable) *List {
 	return &List{generalElement: generalElement}
 }
 
+// NewEmptyListOf creates a list with a known length of 0, the elements added later are checked against generalElement.
+func NewEmptyListOf(generalElement Serializable) *List {
+	return &List{elements: []Serializable{}, generalElement: generalElement}
+}
+
 func (list *List) Test(v Value, state RecTestCallState) bool {
 	state.StartCall()
 	defer state.FinishCall()
@@ -252,7 +266,7 @@ func (list *List) Test(v Value, state RecTestCallState) bool {
 
 func (list *List) IsConcretizable() bool {
 	//TODO: support constraints
-	if list.generalElement != nil {
+	if list.elements == nil {
 		return false
 	}
 
@@ -287,8 +301,7 @@ func (list *List) ToReadonly() (PotentiallyReadonly, error) {
 	}
 
 	if list.generalElement != nil {
-		readonly := NewListOf(list.generalElement)
-		readonly.readonly = true
+		readonly := &List{elements: list.elements, generalElement: list.generalElement, readonly: true}
 		return readonly, nil
 	}
 
@@ -347,6 +360,10 @@ func (list *List) Prop(name string) Value {
 		return WrapGoMethod(list.Sorted)
 	case "sort_by":
 		return WrapGoMethod(list.SortBy)
+	case "insert_sorted":
+		return WrapGoMethod(list.InsertSorted)
+	case "chunk":
+		return WrapGoMethod(list.Chunk)
 	case "len":
 		return ANY_INT
 	default:
@@ -441,6 +458,9 @@ func (l *List) KnownLen() int {
 func (l *List) Element() Value {
 	if l.elements != nil {
 		if len(l.elements) == 0 {
+			if l.generalElement != nil {
+				return l.generalElement
+			}
 			return ANY_SERIALIZABLE
 		}
 		return AsSerializableChecked(joinValues(SerializablesToValues(l.elements)))
@@ -504,7 +524,10 @@ func (l *List) WidestOfType() Value {
 
 func (l *List) slice(start, end *Int) Sequence {
 	if l.HasKnownLen() {
-		return &List{generalElement: ANY_SERIALIZABLE}
+		if startIndex, endIndex, ok := getKnownSliceBounds(start, end, l.KnownLen()); ok {
+			return NewList(slices.Clone(l.elements[startIndex:endIndex])...)
+		}
+		return &List{generalElement: AsSerializableChecked(l.Element())}
 	}
 	return &List{
 		generalElement: l.generalElement,
@@ -536,24 +559,7 @@ func (l *List) insertSequence(ctx *Context, seq Sequence, i *Int) {
 		return
 	}
 
-	if l.HasKnownLen() && l.KnownLen() == 0 {
-		element := seq.Element()
-		if serializable, ok := element.(Serializable); ok {
-			//we could pass a list with a known length but we don't know how many times
-			//the mutation can ocurr (e.g. in for loops).
-			ctx.SetUpdatedSelf(NewListOf(serializable))
-		} else {
-			ctx.AddSymbolicGoFunctionError(NON_SERIALIZABLE_VALUES_NOT_ALLOWED_AS_ELEMENTS_OF_SERIALIZABLE)
-		}
-		return
-	}
-
-	element := AsSerializable(MergeValuesWithSameStaticTypeInMultivalue(joinValues([]Value{l.Element(), seq.Element()})))
-	if serializable, ok := element.(Serializable); ok {
-		ctx.SetUpdatedSelf(NewListOf(serializable))
-	} else {
-		ctx.AddSymbolicGoFunctionError(NON_SERIALIZABLE_VALUES_NOT_ALLOWED_AS_ELEMENTS_OF_SERIALIZABLE)
-	}
+	l.addElementsOf(ctx, seq)
 }
 
 func (l *List) appendSequence(ctx *Context, seq Sequence) {
@@ -566,35 +572,54 @@ func (l *List) appendSequence(ctx *Context, seq Sequence) {
 		return
 	}
 
-	if l.HasKnownLen() && l.KnownLen() == 0 {
+	l.addElementsOf(ctx, seq)
+}
+
+// addElementsOf updates the element type of the list after the elements of seq have been added.
+func (l *List) addElementsOf(ctx *Context, seq Sequence) {
+	if l.HasKnownLen() && l.KnownLen() == 0 && l.generalElement == nil {
 		element := seq.Element()
 		if serializable, ok := element.(Serializable); ok {
 			//we could pass a list with a known length but we don't know how many times
 			//the mutation can ocurr (e.g. in for loops).
-			ctx.SetUpdatedSelf(NewListOf(serializable))
+			updated := NewListOf(serializable)
+			updated.inferredElement = true
+			ctx.SetUpdatedSelf(updated)
 		} else {
 			ctx.AddSymbolicGoFunctionError(NON_SERIALIZABLE_VALUES_NOT_ALLOWED_AS_ELEMENTS_OF_SERIALIZABLE)
 		}
 		return
 	}
 
+	//the element type is kept if it already matches the new elements (e.g. adding an int to a list of (int | str)).
+	if l.generalElement != nil && l.generalElement.Test(seq.Element(), RecTestCallState{}) {
+		if l.HasKnownLen() { //empty list having an expected element type
+			ctx.SetUpdatedSelf(NewListOf(l.generalElement))
+		} else {
+			ctx.SetUpdatedSelf(l)
+		}
+		return
+	}
+
 	element := AsSerializable(MergeValuesWithSameStaticTypeInMultivalue(joinValues([]Value{l.Element(), seq.Element()})))
 	if serializable, ok := element.(Serializable); ok {
-		ctx.SetUpdatedSelf(NewListOf(serializable))
+		updated := NewListOf(serializable)
+		updated.inferredElement = l.inferredElement
+		ctx.SetUpdatedSelf(updated)
 	} else {
 		ctx.AddSymbolicGoFunctionError(NON_SERIALIZABLE_VALUES_NOT_ALLOWED_AS_ELEMENTS_OF_SERIALIZABLE)
 	}
 }
 
 func (l *List) Append(ctx *Context, elements ...Serializable) {
-	if l.generalElement != nil {
+	if l.generalElement != nil && !l.inferredElement {
 		ctx.SetSymbolicGoFunctionParameters(&[]Value{l.Element()}, LIST_APPEND_PARAM_NAMES)
 	}
 	l.appendSequence(ctx, NewList(elements...))
 }
 
 func (l *List) Dequeue(ctx *Context) Serializable {
-	if l.generalElement == nil && l.HasKnownLen() {
+	if l.HasKnownLen() {
 		if l.KnownLen() == 0 {
 			ctx.AddSymbolicGoFunctionError(CANNOT_DEQUEUE_FROM_EMPTY_LIST)
 			return ANY_SERIALIZABLE
@@ -608,7 +633,7 @@ func (l *List) Dequeue(ctx *Context) Serializable {
 }
 
 func (l *List) Pop(ctx *Context) Serializable {
-	if l.generalElement == nil && l.HasKnownLen() {
+	if l.HasKnownLen() {
 		if l.KnownLen() == 0 {
 			ctx.AddSymbolicGoFunctionError(CANNOT_POP_FROM_EMPTY_LIST)
 			return ANY_SERIALIZABLE
@@ -672,6 +697,62 @@ func (l *List) Sorted(ctx *Context, orderIdent *Identifier) *List {
 	return l
 }
 
+func (l *List) Chunk(ctx *Context, size *Int) *List {
+	if size.HasValue() && size.Value() <= 0 {
+		ctx.AddSymbolicGoFunctionError(CHUNK_SIZE_SHOULD_BE_POSITIVE)
+	}
+
+	if l.HasKnownLen() && l.KnownLen() == 0 {
+		return NewList()
+	}
+
+	return NewListOf(NewListOf(l.Element().(Serializable)))
+}
+
+func (l *List) InsertSorted(ctx *Context, v Serializable, orderIdent *Identifier) {
+	if l.generalElement != nil && !l.inferredElement {
+		ctx.SetSymbolicGoFunctionParameters(&[]Value{l.Element(), ANY_IDENTIFIER}, LIST_INSERT_SORTED_PARAM_NAMES)
+	}
+
+	if !orderIdent.HasConcreteName() {
+		ctx.AddSymbolicGoFunctionError("invalid order identifier")
+		return
+	}
+
+	order, ok := OrderFromString(orderIdent.Name())
+	if !ok {
+		ctx.AddSymbolicGoFunctionErrorf("unknown order %q", orderIdent.Name())
+		return
+	}
+
+	switch MergeValuesWithSameStaticTypeInMultivalue(v).(type) {
+	case *Int:
+		switch order {
+		case AscendingOrder, DescendingOrder:
+		default:
+			ctx.AddFormattedSymbolicGoFunctionError("invalid order '%s' for integers, use #asc or #desc", orderIdent.Name())
+		}
+	case *Float:
+		switch order {
+		case AscendingOrder, DescendingOrder:
+		default:
+			ctx.AddFormattedSymbolicGoFunctionError("invalid order '%s' for floats, use #asc or #desc", orderIdent.Name())
+		}
+	case StringLike:
+		switch order {
+		case LexicographicOrder, ReverseLexicographicOrder:
+		default:
+			ctx.AddFormattedSymbolicGoFunctionError("invalid order '%s' for strings, use #lex or #revlex", orderIdent.Name())
+		}
+	default:
+		ctx.AddSymbolicGoFunctionError("only integers, floats and strings can be inserted in a sorted list")
+	}
+
+	if l.generalElement == nil || l.inferredElement || l.HasKnownLen() {
+		l.appendSequence(ctx, NewList(v))
+	}
+}
+
 func (l *List) SortBy(ctx *Context, valuePath ValuePath, orderIdent *Identifier) {
 	if l.HasKnownLen() && l.KnownLen() == 0 {
 		return
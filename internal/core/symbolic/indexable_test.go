@@ -133,7 +133,7 @@ func TestList(t *testing.T) {
 				return
 			}
 
-			assert.Equal(t, NewListOf(INT_1), updatedSelf)
+			assert.Equal(t, &List{generalElement: INT_1, inferredElement: true}, updatedSelf)
 		})
 
 		t.Run("adding two different elements of the same type to an empty list", func(t *testing.T) {
@@ -148,7 +148,7 @@ func TestList(t *testing.T) {
 				return
 			}
 
-			assert.Equal(t, NewListOf(AsSerializableChecked(NewMultivalue(INT_1, INT_2))), updatedSelf)
+			assert.Equal(t, &List{generalElement: AsSerializableChecked(NewMultivalue(INT_1, INT_2)), inferredElement: true}, updatedSelf)
 		})
 
 		t.Run("adding no element to a list with single element", func(t *testing.T) {
@@ -191,6 +191,93 @@ func TestList(t *testing.T) {
 
 			assert.Equal(t, NewListOf(ANY_INT), updatedSelf)
 		})
+
+		t.Run("adding an element of another type to a list whose element type has been inferred", func(t *testing.T) {
+			ctx := NewSymbolicContext(dummyConcreteContext{context.Background()}, nil, nil)
+			state := newSymbolicState(ctx, nil)
+
+			list := &List{generalElement: INT_1, inferredElement: true}
+			list.Append(ctx, NewString("a"))
+
+			updatedSelf, ok := state.consumeUpdatedSelf()
+			if !assert.True(t, ok) {
+				return
+			}
+
+			assert.Equal(t, &List{generalElement: AsSerializableChecked(NewMultivalue(INT_1, NewString("a"))), inferredElement: true}, updatedSelf)
+
+			_, _, _, hasMoreSpecificParams := state.consumeSymbolicGoFunctionParameters()
+			assert.False(t, hasMoreSpecificParams)
+		})
+
+		t.Run("adding an element matching the element type of a list of union", func(t *testing.T) {
+			ctx := NewSymbolicContext(dummyConcreteContext{context.Background()}, nil, nil)
+			state := newSymbolicState(ctx, nil)
+
+			element := AsSerializableChecked(NewMultivalue(ANY_INT, ANY_STR_LIKE))
+			list := NewListOf(element)
+			list.Append(ctx, INT_1)
+
+			updatedSelf, ok := state.consumeUpdatedSelf()
+			if !assert.True(t, ok) {
+				return
+			}
+
+			assert.Equal(t, NewListOf(element), updatedSelf)
+		})
+	})
+
+	t.Run("InsertSorted()", func(t *testing.T) {
+		t.Run("inserting an element of another type in a list whose element type has been inferred", func(t *testing.T) {
+			ctx := NewSymbolicContext(dummyConcreteContext{context.Background()}, nil, nil)
+			state := newSymbolicState(ctx, nil)
+
+			list := &List{generalElement: INT_1, inferredElement: true}
+			list.InsertSorted(ctx, INT_2, NewIdentifier("asc"))
+
+			_, _, _, hasMoreSpecificParams := state.consumeSymbolicGoFunctionParameters()
+			assert.False(t, hasMoreSpecificParams)
+
+			updatedSelf, ok := state.consumeUpdatedSelf()
+			if !assert.True(t, ok) {
+				return
+			}
+
+			assert.Equal(t, &List{generalElement: ANY_INT, inferredElement: true}, updatedSelf)
+		})
+
+		t.Run("list whose element type has not been inferred", func(t *testing.T) {
+			ctx := NewSymbolicContext(dummyConcreteContext{context.Background()}, nil, nil)
+			state := newSymbolicState(ctx, nil)
+
+			list := NewListOf(ANY_INT)
+			list.InsertSorted(ctx, INT_2, NewIdentifier("asc"))
+
+			params, _, _, hasMoreSpecificParams := state.consumeSymbolicGoFunctionParameters()
+			if assert.True(t, hasMoreSpecificParams) {
+				assert.Equal(t, []Value{ANY_INT, ANY_IDENTIFIER}, params)
+			}
+		})
+
+		t.Run("empty list with an expected element type", func(t *testing.T) {
+			ctx := NewSymbolicContext(dummyConcreteContext{context.Background()}, nil, nil)
+			state := newSymbolicState(ctx, nil)
+
+			list := NewEmptyListOf(ANY_INT)
+			list.InsertSorted(ctx, INT_2, NewIdentifier("asc"))
+
+			params, _, _, hasMoreSpecificParams := state.consumeSymbolicGoFunctionParameters()
+			if assert.True(t, hasMoreSpecificParams) {
+				assert.Equal(t, []Value{ANY_INT, ANY_IDENTIFIER}, params)
+			}
+
+			updatedSelf, ok := state.consumeUpdatedSelf()
+			if !assert.True(t, ok) {
+				return
+			}
+
+			assert.Equal(t, NewListOf(ANY_INT), updatedSelf)
+		})
 	})
 
 	t.Run("Pop()", func(t *testing.T) {
@@ -267,6 +354,47 @@ func TestList(t *testing.T) {
 
 	})
 
+	t.Run("Chunk()", func(t *testing.T) {
+		t.Run("list of known length 7", func(t *testing.T) {
+			ctx := NewSymbolicContext(dummyConcreteContext{context.Background()}, nil, nil)
+			state := newSymbolicState(ctx, nil)
+
+			list := NewList(INT_1, INT_1, INT_1, INT_1, INT_1, INT_1, INT_1)
+			chunks := list.Chunk(ctx, NewInt(3))
+
+			assert.Equal(t, NewListOf(NewListOf(INT_1)), chunks)
+
+			state.consumeSymbolicGoFunctionErrors(func(msg string) {
+				assert.Fail(t, "no error should have been added", msg)
+			})
+		})
+
+		t.Run("list of unknown length", func(t *testing.T) {
+			ctx := NewSymbolicContext(dummyConcreteContext{context.Background()}, nil, nil)
+			newSymbolicState(ctx, nil)
+
+			list := NewListOf(ANY_STRING)
+			chunks := list.Chunk(ctx, ANY_INT)
+
+			assert.Equal(t, NewListOf(NewListOf(ANY_STRING)), chunks)
+		})
+
+		t.Run("size is not positive", func(t *testing.T) {
+			ctx := NewSymbolicContext(dummyConcreteContext{context.Background()}, nil, nil)
+			state := newSymbolicState(ctx, nil)
+
+			list := NewListOf(ANY_INT)
+			list.Chunk(ctx, NewInt(0))
+
+			err := false
+			state.consumeSymbolicGoFunctionErrors(func(msg string) {
+				err = true
+				assert.Equal(t, CHUNK_SIZE_SHOULD_BE_POSITIVE, msg)
+			})
+			assert.True(t, err)
+		})
+	})
+
 	t.Run("ToReadonly()", func(t *testing.T) {
 
 		t.Run("already readonly", func(t *testing.T) {
@@ -16,6 +16,12 @@ var (
 // A Mapping represents a symbolic Mapping.
 type Mapping struct {
 	shared bool
+
+	//union of the key types and union of the value types of the entries,
+	//nil if unknown.
+	keyType   Value
+	valueType Value
+
 	SerializableMixin
 }
 
@@ -27,9 +33,30 @@ func (m *Mapping) Test(v Value, state RecTestCallState) bool {
 	state.StartCall()
 	defer state.FinishCall()
 
-	_, ok := v.(*Mapping)
+	other, ok := v.(*Mapping)
+	if !ok {
+		return false
+	}
+
+	if m.keyType == nil || m.valueType == nil {
+		return true
+	}
+
+	if other.keyType == nil || other.valueType == nil {
+		return false
+	}
 
-	return ok
+	return m.keyType.Test(other.keyType, state) && m.valueType.Test(other.valueType, state)
+}
+
+// KeyType returns the union of the key types of the entries, the result is nil if the key type is unknown.
+func (m *Mapping) KeyType() Value {
+	return m.keyType
+}
+
+// ValueType returns the union of the value types of the entries, the result is nil if the value type is unknown.
+func (m *Mapping) ValueType() Value {
+	return m.valueType
 }
 
 func (m *Mapping) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.PrettyPrintConfig) {
@@ -59,7 +86,9 @@ func (m *Mapping) Share(originState *State) PotentiallySharable {
 		return m
 	}
 	return &Mapping{
-		shared: true,
+		shared:    true,
+		keyType:   m.keyType,
+		valueType: m.valueType,
 	}
 }
 
@@ -92,5 +121,8 @@ func (*Mapping) PropertyNames() []string {
 }
 
 func (m *Mapping) Compute(ctx *Context, key Value) Value {
-	return ANY
+	if m.valueType == nil {
+		return ANY
+	}
+	return m.valueType
 }
@@ -3,10 +3,13 @@ package symbolic
 import (
 	"errors"
 	"reflect"
+	"strings"
 
 	"github.com/inoxlang/inox/internal/inoxconsts"
 	"github.com/inoxlang/inox/internal/parse"
 	pprint "github.com/inoxlang/inox/internal/prettyprint"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
 )
 
 var (
@@ -42,6 +45,29 @@ func (mod *Module) Name() string {
 	return mod.mainChunk.Name()
 }
 
+// chunks returns the main chunk of the module, followed by the included chunks and the chunks of
+// the (transitively) imported modules. The order is deterministic.
+func (mod *Module) chunks() []*parse.Chunk {
+	chunks := []*parse.Chunk{mod.mainChunk.Node}
+
+	includedChunks := maps.Values(mod.inclusionStatementMap)
+	slices.SortFunc(includedChunks, func(a, b *IncludedChunk) int {
+		return strings.Compare(a.Name(), b.Name())
+	})
+	for _, chunk := range includedChunks {
+		chunks = append(chunks, chunk.Node)
+	}
+
+	importStmts := maps.Keys(mod.directlyImportedModules)
+	slices.SortFunc(importStmts, func(a, b *parse.ImportStatement) int {
+		return int(a.Span.Start - b.Span.Start)
+	})
+	for _, stmt := range importStmts {
+		chunks = append(chunks, mod.directlyImportedModules[stmt].chunks()...)
+	}
+	return chunks
+}
+
 func (mod *Module) GetLineColumn(node parse.Node) (int32, int32) {
 	return mod.mainChunk.GetLineColumn(node)
 }
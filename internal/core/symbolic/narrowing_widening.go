@@ -4,7 +4,9 @@ import (
 	"errors"
 	"reflect"
 	"slices"
+	"sort"
 
+	"github.com/inoxlang/inox/internal/globals/globalnames"
 	"github.com/inoxlang/inox/internal/parse"
 	"github.com/inoxlang/inox/internal/utils"
 )
@@ -200,6 +202,67 @@ func narrowOut(narrowedOut Value, toNarrow Value) Value {
 	return toNarrow
 }
 
+// narrowToMatchedValue returns the value of a variable (or chain) after a successful match against a pattern
+// whose symbolic value is patternValue. The current value is kept if it is more specific than patternValue, if
+// it is a multivalue the values that are matched by the pattern are kept.
+func narrowToMatchedValue(current Value, patternValue Value) Value {
+	if current == nil {
+		return patternValue
+	}
+
+	if patternValue.Test(current, RecTestCallState{}) {
+		return current
+	}
+
+	var multivalue *Multivalue
+	switch c := current.(type) {
+	case *Multivalue:
+		multivalue = c
+	case IMultivalue:
+		multivalue = c.OriginalMultivalue()
+	default:
+		return patternValue
+	}
+
+	var matchedValues []Value
+	for _, val := range multivalue.values {
+		if patternValue.Test(val, RecTestCallState{}) {
+			matchedValues = append(matchedValues, val)
+		}
+	}
+
+	switch len(matchedValues) {
+	case 0:
+		return patternValue
+	case 1:
+		return matchedValues[0]
+	}
+	return NewMultivalue(matchedValues...)
+}
+
+// knownKeysOfExactObject returns a string union of the (required and optional) property names of an exact object.
+func knownKeysOfExactObject(obj *Object) (Value, bool) {
+	if obj.IsInexact() || obj.MatchAnyObject() {
+		return nil, false
+	}
+
+	names := append(obj.PropertyNames(), obj.OptionalPropertyNames()...)
+	if len(names) == 0 {
+		return nil, false
+	}
+	sort.Strings(names)
+
+	keys := make([]Value, len(names))
+	for i, name := range names {
+		keys[i] = NewString(name)
+	}
+
+	if len(keys) == 1 {
+		return keys[0], true
+	}
+	return NewMultivalue(keys...), true
+}
+
 func narrow(positive bool, n parse.Node, state *State, targetState *State) {
 
 	if unaryExpr, ok := n.(*parse.UnaryExpression); ok && unaryExpr.Operator == parse.BoolNegate {
@@ -217,17 +280,32 @@ func narrow(positive bool, n parse.Node, state *State, targetState *State) {
 
 	if binExpr, ok := n.(*parse.BinaryExpression); ok && state.symbolicData != nil {
 		switch {
-		case binExpr.Operator == parse.Match:
+		case binExpr.Operator == parse.Match || binExpr.Operator == parse.NotMatch:
 			right, _ := state.symbolicData.GetMostSpecificNodeValue(binExpr.Right)
 			if pattern, ok := right.(Pattern); ok {
+				if binExpr.Operator == parse.NotMatch {
+					positive = !positive
+				}
+
 				//we narrow the left operand
 				if positive {
-					narrowChain(binExpr.Left, setExactValue, pattern.SymbolicValue(), targetState, 0)
+					left, _ := state.symbolicData.GetMostSpecificNodeValue(binExpr.Left)
+					narrowChain(binExpr.Left, setExactValue, narrowToMatchedValue(left, pattern.SymbolicValue()), targetState, 0)
 				} else {
 					narrowChain(binExpr.Left, removePossibleValue, pattern.SymbolicValue(), targetState, 0)
 				}
 			}
 
+		case positive && binExpr.Operator == parse.Keyof:
+			right, _ := state.symbolicData.GetMostSpecificNodeValue(binExpr.Right)
+			if obj, ok := right.(*Object); ok {
+				if keys, ok := knownKeysOfExactObject(obj); ok {
+					//we narrow the left operand to the object's keys it can be equal to.
+					left, _ := state.symbolicData.GetMostSpecificNodeValue(binExpr.Left)
+					narrowChain(binExpr.Left, setExactValue, narrowToMatchedValue(left, keys), targetState, 0)
+				}
+			}
+
 		// (==) or negated (!=)
 		case (positive && binExpr.Operator == parse.Equal) || (!positive && binExpr.Operator == parse.NotEqual):
 			//we narrow one of the operands
@@ -242,6 +320,20 @@ func narrow(positive bool, n parse.Node, state *State, targetState *State) {
 				state.addError(makeSymbolicEvalError(binExpr, state, fmtVal1Val2HaveNoOverlap(left, right)))
 			}
 
+		// (<) or negated (>=): `i < len(list)`
+		case (positive && binExpr.Operator == parse.LessThan) || (!positive && binExpr.Operator == parse.GreaterOrEqual):
+			index, indexed, ok := getIndexAndLengthOperands(binExpr.Left, binExpr.Right)
+			if ok {
+				targetState.addIndexBoundsGuard(index, indexed)
+			}
+
+		// (>) or negated (<=): `len(list) > i`
+		case (positive && binExpr.Operator == parse.GreaterThan) || (!positive && binExpr.Operator == parse.LessOrEqual):
+			index, indexed, ok := getIndexAndLengthOperands(binExpr.Right, binExpr.Left)
+			if ok {
+				targetState.addIndexBoundsGuard(index, indexed)
+			}
+
 		// (!=) or negated (==)
 		case (positive && binExpr.Operator == parse.NotEqual) || (!positive && binExpr.Operator == parse.Equal):
 			//we narrow one of the operands
@@ -249,12 +341,54 @@ func narrow(positive bool, n parse.Node, state *State, targetState *State) {
 			left, _ := state.symbolicData.GetMostSpecificNodeValue(binExpr.Left)
 			right, _ := state.symbolicData.GetMostSpecificNodeValue(binExpr.Right)
 
-			narrowChain(binExpr.Left, removePossibleValue, right, targetState, 0)
-			narrowChain(binExpr.Right, removePossibleValue, left, targetState, 0)
+			//a value is only removed if it is a constant: `a != b` with b being any integer does not imply that a is not an integer.
+			if isConstantForNarrowing(right) {
+				narrowChain(binExpr.Left, removePossibleValue, right, targetState, 0)
+			}
+			if isConstantForNarrowing(left) {
+				narrowChain(binExpr.Right, removePossibleValue, left, targetState, 0)
+			}
 		}
 	}
 }
 
+// isConstantForNarrowing returns true if v is an immutable value that is fully known (e.g. 1, "a", true, nil).
+func isConstantForNarrowing(v Value) bool {
+	return v != nil && !v.IsMutable() && IsConcretizable(v)
+}
+
+// getIndexAndLengthOperands returns the names of the index variable and of the indexed variable
+// if indexNode is a variable and lengthNode is the length of a variable: `len(list)` or `list.len`.
+func getIndexAndLengthOperands(indexNode, lengthNode parse.Node) (index string, indexed string, _ bool) {
+	index, ok := parse.GetNameIfVariable(indexNode)
+	if !ok {
+		return "", "", false
+	}
+
+	switch n := lengthNode.(type) {
+	case *parse.CallExpression:
+		callee, ok := n.Callee.(*parse.IdentifierLiteral)
+		if !ok || callee.Name != globalnames.LEN_FN || len(n.Arguments) != 1 {
+			return "", "", false
+		}
+		indexed, ok = parse.GetNameIfVariable(n.Arguments[0])
+	case *parse.IdentifierMemberExpression:
+		if len(n.PropertyNames) != 1 || n.PropertyNames[0].Name != "len" {
+			return "", "", false
+		}
+		indexed, ok = n.Left.Name, true
+	case *parse.MemberExpression:
+		if n.PropertyName.Name != "len" {
+			return "", "", false
+		}
+		indexed, ok = parse.GetNameIfVariable(n.Left)
+	default:
+		return "", "", false
+	}
+
+	return index, indexed, ok
+}
+
 type chainNarrowing int
 
 const (
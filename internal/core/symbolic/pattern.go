@@ -35,7 +35,7 @@ var (
 		(*IntersectionPattern)(nil),
 	}
 	_ = []GroupPattern{
-		(*NamedSegmentPathPattern)(nil),
+		(*NamedSegmentPathPattern)(nil), (*RegexPattern)(nil),
 	}
 
 	_ = []IPropsPattern{
@@ -862,8 +862,23 @@ func (p *NamedSegmentPathPattern) StringPattern() (StringPattern, bool) {
 }
 
 func (p *NamedSegmentPathPattern) MatchGroups(v Value) (bool, map[string]Serializable) {
-	//TODO
-	return false, nil
+	path, ok := v.(*Path)
+	if !ok {
+		return false, nil
+	}
+
+	//it's not possible to know the exact value of the segments.
+	groups := map[string]Serializable{"0": path}
+
+	if p.node != nil {
+		for _, slice := range p.node.Slices {
+			if segment, ok := slice.(*parse.NamedPathSegment); ok {
+				groups[segment.Name] = ANY_STRING
+			}
+		}
+	}
+
+	return true, groups
 }
 
 func (p *NamedSegmentPathPattern) PropertyNames() []string {
@@ -1085,6 +1100,45 @@ func (p *RegexPattern) HasRegex() bool {
 	return true
 }
 
+func (p *RegexPattern) MatchGroups(v Value) (bool, map[string]Serializable) {
+	s, ok := v.(StringLike)
+	if !ok {
+		return false, nil
+	}
+
+	str := s.GetOrBuildString()
+	if p.regex != nil && str.hasValue && !p.regex.MatchString(str.value) {
+		return false, nil
+	}
+
+	groups := map[string]Serializable{"0": s}
+	if p.regex == nil {
+		return true, groups
+	}
+
+	//the submatches are only known if the value of the string is known.
+	var submatches []string
+	if str.hasValue {
+		submatches = p.regex.FindStringSubmatch(str.value)
+	}
+
+	groupNames := p.regex.SubexpNames()
+
+	for i := 1; i < len(groupNames); i++ {
+		var group Serializable = ANY_STRING
+		if submatches != nil {
+			group = NewString(submatches[i])
+		}
+
+		groups[strconv.Itoa(i)] = group
+		if groupNames[i] != "" {
+			groups[groupNames[i]] = group
+		}
+	}
+
+	return true, groups
+}
+
 func (p *RegexPattern) SymbolicValue() Value {
 	return NewStringMatchingPattern(p)
 }
@@ -2418,6 +2472,15 @@ func (p *TuplePattern) MigrationInitialValue() (Serializable, bool) {
 	return tuple, true
 }
 
+// IsReadonlyPattern returns true because tuples are immutable.
+func (p *TuplePattern) IsReadonlyPattern() bool {
+	return true
+}
+
+func (p *TuplePattern) ToReadonlyPattern() (PotentiallyReadonlyPattern, error) {
+	return p, nil
+}
+
 func (p *TuplePattern) StringPattern() (StringPattern, bool) {
 	return nil, false
 }
@@ -2569,8 +2632,8 @@ func (p *UnionPattern) TestValue(v Value, state RecTestCallState) bool {
 	defer state.FinishCall()
 
 	var values []Value
-	if multi, ok := v.(*Multivalue); ok {
-		values = multi.values
+	if multi, ok := v.(IMultivalue); ok {
+		values = multi.OriginalMultivalue().values
 	} else {
 		values = []Value{v}
 	}
@@ -2829,6 +2892,12 @@ func (p *OptionPattern) WidestOfType() Value {
 }
 
 func evalPatternNode(n parse.Node, state *State) (Pattern, error) {
+	return evalPatternNodeExt(n, state, false)
+}
+
+// evalPatternNodeExt is like evalPatternNode, if multivaluesToUnions is true a multivalue of immutable serializable
+// values is converted to a union of exact value patterns.
+func evalPatternNodeExt(n parse.Node, state *State, multivaluesToUnions bool) (Pattern, error) {
 	switch node := n.(type) {
 	case *parse.ObjectPatternLiteral,
 		*parse.RecordPatternLiteral,
@@ -2866,6 +2935,12 @@ func evalPatternNode(n parse.Node, state *State) (Pattern, error) {
 			return patt, nil
 		}
 
+		if multi, ok := v.(*Multivalue); ok && multivaluesToUnions {
+			if pattern, ok := makeUnionPatternOfExactValuePatterns(multi); ok {
+				return pattern, nil
+			}
+		}
+
 		var exactValue Serializable
 
 		if v.IsMutable() {
@@ -2887,15 +2962,62 @@ func evalPatternNode(n parse.Node, state *State) (Pattern, error) {
 	}
 }
 
+// makeUnionPatternOfExactValuePatterns creates a union of exact value patterns, one for each value of multi.
+// ok is false if at least one value is mutable or not serializable.
+func makeUnionPatternOfExactValuePatterns(multi *Multivalue) (_ Pattern, ok bool) {
+	var cases []Pattern
+
+	for _, val := range multi.getValues() {
+		if val.IsMutable() {
+			return nil, false
+		}
+		serializable, ok := AsSerializable(val).(Serializable)
+		if !ok {
+			return nil, false
+		}
+		pattern, err := NewMostAdaptedExactPattern(serializable)
+		if err != nil {
+			return nil, false
+		}
+		cases = append(cases, pattern)
+	}
+
+	union, err := NewUnionPattern(cases, false)
+	if err != nil {
+		return nil, false
+	}
+	return union, true
+}
+
 type TypePattern struct {
 	val                 Value //symbolic value that represents concrete values matching, if nil any TypePattern is matched.
 	call                func(ctx *Context, values []Value) (Pattern, error)
+	callArity           *CallArity //optional
 	stringPattern       func() (StringPattern, bool)
 	concreteTypePattern any //we play safe
 
 	SerializableMixin
 }
 
+// CallArity is the number of arguments accepted by a callable pattern, a negative .Max means there is no maximum.
+type CallArity struct {
+	Min, Max int
+}
+
+// SetCallArity sets the number of arguments accepted by the pattern, the number of arguments of pattern
+// call expressions is checked before calling the pattern.
+func (p *TypePattern) SetCallArity(arity CallArity) {
+	p.callArity = &arity
+}
+
+// CallArity returns the number of arguments accepted by the pattern, ok is false if it is not known.
+func (p *TypePattern) CallArity() (_ CallArity, ok bool) {
+	if p.callArity == nil {
+		return CallArity{}, false
+	}
+	return *p.callArity, true
+}
+
 func NewTypePattern(
 	value Value, call func(ctx *Context, values []Value) (Pattern, error),
 	stringPattern func() (StringPattern, bool), concrete any,
@@ -3095,6 +3095,15 @@ func TestSymbolicOptionPattern(t *testing.T) {
 		assertTestValueFalse(t, pattern, ANY_INT)
 		assertTestValueFalse(t, pattern, ANY_OPTION_PATTERN)
 
+		intOptionPattern := NewOptionPattern("flag", &TypePattern{val: ANY_INT})
+
+		assertTestValue(t, intOptionPattern, NewOption("flag", NewInt(5)))
+		assertTestValue(t, intOptionPattern, NewOption("flag", ANY_INT))
+		assertTestValueFalse(t, intOptionPattern, NewOption("flag", EMPTY_STRING))
+		assertTestValueFalse(t, intOptionPattern, NewOption("flag", ANY_SERIALIZABLE))
+		assertTestValueFalse(t, intOptionPattern, NewOption("other", NewInt(5)))
+		assertTestValueFalse(t, intOptionPattern, NewAnyNameOption(NewInt(5)))
+
 		anyOptionPattern := ANY_OPTION_PATTERN
 
 		assertTestValue(t, anyOptionPattern, ANY_OPTION)
@@ -43,6 +43,41 @@ func Stringify(v Value) string {
 	return buff.String()
 }
 
+// StringifyColored returns the output of Stringify wrapped in the color sequence of the value's kind
+// (pattern, primitive or container). If colors is nil the output is not colorized.
+func StringifyColored(v Value, colors *pprint.PrettyPrintColors) string {
+	str := Stringify(v)
+	if colors == nil {
+		return str
+	}
+
+	color := getColorOfValueKind(v, colors)
+	if len(color) == 0 {
+		return str
+	}
+
+	return string(color) + str + string(pprint.ANSI_RESET_SEQUENCE)
+}
+
+func getColorOfValueKind(v Value, colors *pprint.PrettyPrintColors) []byte {
+	switch v.(type) {
+	case Pattern:
+		return colors.PatternLiteral
+	case *Bool, *NilT:
+		return colors.Constant
+	case Integral, *Float:
+		return colors.NumberLiteral
+	case ResourceName:
+		return colors.PathLiteral
+	case StringLike:
+		return colors.StringLiteral
+	case IProps, Indexable, Collection:
+		return colors.IdentifierLiteral
+	default:
+		return nil
+	}
+}
+
 // Stringify calls PrettyPrint on the passed value
 func StringifyComptimeType(t CompileTimeType) string {
 	buff := &bytes.Buffer{}
@@ -0,0 +1,40 @@
+package symbolic
+
+import (
+	"testing"
+
+	pprint "github.com/inoxlang/inox/internal/prettyprint"
+	"github.com/inoxlang/inox/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringifyColored(t *testing.T) {
+	colors := &pprint.DEFAULT_DARKMODE_PRINT_COLORS
+
+	values := []Value{
+		ANY_INT, NewInt(1), ANY_FLOAT, TRUE, Nil, ANY_STRING, NewString("a"), ANY_PATH,
+		NewInexactObject2(map[string]Serializable{"a": ANY_INT}), NewListOf(ANY_INT),
+		ANY_INT_RANGE_PATTERN, NewMultivalue(ANY_INT, ANY_STRING),
+	}
+
+	t.Run("colored output should strip back to the plain output", func(t *testing.T) {
+		for _, v := range values {
+			plain := Stringify(v)
+			colored := StringifyColored(v, colors)
+
+			assert.Equal(t, plain, utils.StripANSISequences(colored))
+		}
+	})
+
+	t.Run("the color depends on the kind of value", func(t *testing.T) {
+		assert.Equal(t, string(colors.NumberLiteral)+"%int(1)"+string(pprint.ANSI_RESET_SEQUENCE), StringifyColored(NewInt(1), colors))
+		assert.Equal(t, string(colors.PatternLiteral)+Stringify(ANY_INT_RANGE_PATTERN)+string(pprint.ANSI_RESET_SEQUENCE), StringifyColored(ANY_INT_RANGE_PATTERN, colors))
+		assert.Equal(t, string(colors.Constant)+"true"+string(pprint.ANSI_RESET_SEQUENCE), StringifyColored(TRUE, colors))
+	})
+
+	t.Run("no color", func(t *testing.T) {
+		for _, v := range values {
+			assert.Equal(t, Stringify(v), StringifyColored(v, nil))
+		}
+	})
+}
@@ -11,7 +11,7 @@ var (
 	}
 
 	_ = []PotentiallyReadonlyPattern{
-		(*ObjectPattern)(nil), (*ListPattern)(nil),
+		(*ObjectPattern)(nil), (*ListPattern)(nil), (*TuplePattern)(nil),
 	}
 )
 
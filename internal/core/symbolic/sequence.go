@@ -31,6 +31,34 @@ type MutableLengthSequence interface {
 	appendSequence(ctx *Context, seq Sequence)
 }
 
+// getKnownSliceBounds returns the bounds of a slice operation on a sequence of known length,
+// ok is false if one of the bounds is not known or if the bounds are invalid. A nil start (or end)
+// index is treated as the start (or end) of the sequence.
+func getKnownSliceBounds(start, end *Int, length int) (startIndex, endIndex int, ok bool) {
+	startIndex = 0
+	endIndex = length
+
+	if start != nil {
+		if !start.hasValue {
+			return 0, 0, false
+		}
+		startIndex = int(start.value)
+	}
+
+	if end != nil {
+		if !end.hasValue {
+			return 0, 0, false
+		}
+		endIndex = min(int(end.value), length)
+	}
+
+	if startIndex < 0 || startIndex > endIndex {
+		return 0, 0, false
+	}
+
+	return startIndex, endIndex, true
+}
+
 type AnySequenceOf struct {
 	elem Value
 }
@@ -30,6 +30,7 @@ type State struct {
 	conditionalReturn     bool
 	iterationChange       IterationChange
 	checkXMLInterpolation XMLInterpolationCheckingFunction
+	checkXMLAttribute     XMLAttributeCheckingFunction
 	Module                *Module
 
 	//base globals and patterns
@@ -49,14 +50,30 @@ type State struct {
 
 	lastErrorNode        parse.Node
 	symbolicData         *Data
+	previousSymbolicData *Data //data of a previous check, can be nil
 	shellTrustedCommands []string
 
+	//bound checks (e.g. `i < len(list)`) guarding the current block
+	indexBoundsGuards []indexBoundsGuard
+
+	//if true the reads of captured locals are tracked in .readLocals, see EvalCheckInput.CheckUnusedCapturedLocals.
+	checkUnusedCapturedLocals bool
+	//names of the locals read by the current function, nil if the reads are not tracked (shared by forks)
+	readLocals map[string]struct{}
+
 	testedProgram *TestedProgram //can be nil
 
 	//nil if no project
 	projectFilesystem billy.Filesystem
 }
 
+// An indexBoundsGuard represents a check such as `i < len(list)`: indexing the variable named .indexed
+// with the variable named .index is in bounds as long as none of the two variables is reassigned.
+type indexBoundsGuard struct {
+	index   string
+	indexed string
+}
+
 type scopeInfo struct {
 	self      Value //can be nil
 	nextSelf  Value //can be nil
@@ -323,6 +340,13 @@ func (state *State) getLocal(name string) (varSymbolicInfo, bool) {
 	return varSymbolicInfo{}, false
 }
 
+// markLocalAsRead records that a local has been read if the reads are tracked.
+func (state *State) markLocalAsRead(name string) {
+	if state.readLocals != nil {
+		state.readLocals[name] = struct{}{}
+	}
+}
+
 func (state *State) get(name string) (varSymbolicInfo, bool) {
 	if state.hasLocal(name) {
 		return state.getLocal(name)
@@ -359,6 +383,10 @@ func (state *State) updateLocal2(
 		}
 		info.value = value
 
+		if !narrowing {
+			state.removeIndexBoundsGuards(name)
+		}
+
 		if !isNever(value) {
 			if !deeperMismatch && !info.static.TestValue(value, RecTestCallState{}) {
 				msg := ""
@@ -377,6 +405,21 @@ func (state *State) updateLocal2(
 	return false, nil
 }
 
+func (state *State) addIndexBoundsGuard(index, indexed string) {
+	state.indexBoundsGuards = append(state.indexBoundsGuards, indexBoundsGuard{index: index, indexed: indexed})
+}
+
+// removeIndexBoundsGuards removes the guards involving a variable, it should be called when the variable is reassigned.
+func (state *State) removeIndexBoundsGuards(varname string) {
+	state.indexBoundsGuards = slices.DeleteFunc(state.indexBoundsGuards, func(guard indexBoundsGuard) bool {
+		return guard.index == varname || guard.indexed == varname
+	})
+}
+
+func (state *State) isIndexInBounds(index, indexed string) bool {
+	return slices.Contains(state.indexBoundsGuards, indexBoundsGuard{index: index, indexed: indexed})
+}
+
 func (state *State) updateGlobal(name string, value Value, node parse.Node) bool {
 	ok, _ := state.updateGlobal2(name, node, func(expected Value) (Value, bool, error) {
 		return value, false, nil
@@ -405,6 +448,10 @@ func (state *State) updateGlobal2(
 		}
 		info.value = value
 
+		if !narrowing {
+			state.removeIndexBoundsGuards(name)
+		}
+
 		if !isNever(value) {
 			if !deeperMismatch && !info.static.TestValue(value, RecTestCallState{}) {
 				msg := ""
@@ -574,7 +621,11 @@ func (state *State) fork() *State {
 	child.basePatterns = state.basePatterns
 	child.basePatternNamespaces = state.basePatternNamespaces
 	child.checkXMLInterpolation = state.checkXMLInterpolation
+	child.checkXMLAttribute = state.checkXMLAttribute
 	child.projectFilesystem = state.projectFilesystem
+	child.indexBoundsGuards = slices.Clone(state.indexBoundsGuards)
+	child.checkUnusedCapturedLocals = state.checkUnusedCapturedLocals
+	child.readLocals = state.readLocals
 
 	globalScopeCopy := &scopeInfo{
 		variables: make(map[string]varSymbolicInfo, 0),
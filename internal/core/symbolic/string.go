@@ -192,11 +192,15 @@ func (s *String) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.PrettyPr
 }
 
 func (s *String) HasKnownLen() bool {
-	return false
+	return s.hasValue
 }
 
+// KnownLen returns the length in bytes of the string if the value is known, -1 otherwise.
 func (s *String) KnownLen() int {
-	return -1
+	if !s.hasValue {
+		return -1
+	}
+	return len(s.value)
 }
 
 func (s *String) Element() Value {
@@ -267,6 +271,11 @@ func (s *String) Prop(name string) Value {
 }
 
 func (s *String) slice(start, end *Int) Sequence {
+	if s.hasValue {
+		if startIndex, endIndex, ok := getKnownSliceBounds(start, end, len(s.value)); ok {
+			return NewString(s.value[startIndex:endIndex])
+		}
+	}
 	return ANY_STRING
 }
 
@@ -660,7 +669,8 @@ func (s *AnyStringLike) IteratorElementValue() Value {
 }
 
 func (s *AnyStringLike) slice(start, end *Int) Sequence {
-	return ANY_STR_LIKE
+	//slicing any string-like value produces a string.
+	return ANY_STRING
 }
 
 func (s *AnyStringLike) KnownLen() int {
@@ -14,6 +14,8 @@ const (
 	TEST_ITEM_META__PASS_LIVE_FS_COPY  = "pass-live-fs-copy-to-subtests"
 	TEST_ITEM_META__MAIN_DB_SCHEMA     = "main-db-schema"
 	TEST_ITEM_META__MAIN_DB_MIGRATIONS = "main-db-migrations"
+
+	TEST_ITEM__NAME_PROPNAME = "name"
 )
 
 var (
@@ -54,6 +56,7 @@ var (
 	ANY_CURRENT_TEST              = &CurrentTest{testedProgram: ANY_TESTED_PROGRAM_OR_NIL}
 	ANY_CURRENT_TEST_WITH_PROGRAM = &CurrentTest{testedProgram: ANY_TESTED_PROGRAM}
 
+	TEST_ITEM_PROPNAMES      = []string{TEST_ITEM__NAME_PROPNAME, "run"}
 	CURRENT_TEST_PROPNAMES   = []string{"program"}
 	TESTED_PROGRAM_PROPNAMES = []string{"is-done", "cancel", "dbs"}
 )
@@ -61,7 +64,12 @@ var (
 // A TestSuite represents a symbolic TestSuite.
 type TestSuite struct {
 	UnassignablePropsMixin
-	_ int
+	name *String //nil if the test suite has no name
+}
+
+// NewTestSuite creates a symbolic test suite, $name can be nil.
+func NewTestSuite(name *String) *TestSuite {
+	return &TestSuite{name: name}
 }
 
 func (s *TestSuite) Test(v Value, state RecTestCallState) bool {
@@ -92,7 +100,15 @@ func (s *TestSuite) GetGoMethod(name string) (*GoFunction, bool) {
 	return nil, false
 }
 
+// Name returns the name of the test suite, the result is nil if the test suite has no name.
+func (s *TestSuite) Name() *String {
+	return s.name
+}
+
 func (s *TestSuite) Prop(name string) Value {
+	if name == TEST_ITEM__NAME_PROPNAME {
+		return getTestItemNameProp(s.name)
+	}
 	method, ok := s.GetGoMethod(name)
 	if !ok {
 		panic(FormatErrPropertyDoesNotExist(name, s))
@@ -101,7 +117,7 @@ func (s *TestSuite) Prop(name string) Value {
 }
 
 func (*TestSuite) PropertyNames() []string {
-	return []string{"run"}
+	return TEST_ITEM_PROPNAMES
 }
 
 func (s *TestSuite) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.PrettyPrintConfig) {
@@ -112,7 +128,12 @@ func (s *TestSuite) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.Prett
 // A TestCase represents a symbolic TestCase.
 type TestCase struct {
 	UnassignablePropsMixin
-	_ int
+	name *String //nil if the test case has no name
+}
+
+// NewTestCase creates a symbolic test case, $name can be nil.
+func NewTestCase(name *String) *TestCase {
+	return &TestCase{name: name}
 }
 
 func (s *TestCase) Test(v Value, state RecTestCallState) bool {
@@ -131,11 +152,27 @@ func (s *TestCase) WidestOfType() Value {
 	return ANY_TEST_CASE
 }
 
+func (s *TestCase) Run(ctx *Context, options ...*Option) (*LThread, *Error) {
+	return ANY_LTHREAD, nil
+}
+
 func (s *TestCase) GetGoMethod(name string) (*GoFunction, bool) {
+	switch name {
+	case "run":
+		return WrapGoMethod(s.Run), true
+	}
 	return nil, false
 }
 
+// Name returns the name of the test case, the result is nil if the test case has no name.
+func (s *TestCase) Name() *String {
+	return s.name
+}
+
 func (s *TestCase) Prop(name string) Value {
+	if name == TEST_ITEM__NAME_PROPNAME {
+		return getTestItemNameProp(s.name)
+	}
 	method, ok := s.GetGoMethod(name)
 	if !ok {
 		panic(FormatErrPropertyDoesNotExist(name, s))
@@ -144,20 +181,28 @@ func (s *TestCase) Prop(name string) Value {
 }
 
 func (*TestCase) PropertyNames() []string {
-	return nil
+	return TEST_ITEM_PROPNAMES
 }
 
 func (s *TestCase) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.PrettyPrintConfig) {
 	w.WriteName("test-case")
 }
 
+func getTestItemNameProp(name *String) Value {
+	if name == nil {
+		return EMPTY_STRING
+	}
+	return name
+}
+
 // checkTestItemMeta evaluates & checks the meta value of a test item, it returns a *CurrentTest for test cases.
-func checkTestItemMeta(node parse.Node, state *State, isTestCase bool) (currentTest *CurrentTest, testedProgram *TestedProgram, _ error) {
+// The returned name is nil if the meta value does not specify a name.
+func checkTestItemMeta(node parse.Node, state *State, isTestCase bool) (currentTest *CurrentTest, testedProgram *TestedProgram, name *String, _ error) {
 	meta, err := _symbolicEval(node, state, evalOptions{
 		expectedValue: TEST_ITEM__EXPECTED_META_VALUE,
 	})
 	if err != nil {
-		return nil, nil, err
+		return nil, nil, nil, err
 	}
 
 	if isTestCase {
@@ -168,6 +213,12 @@ func checkTestItemMeta(node parse.Node, state *State, isTestCase bool) (currentT
 
 	switch m := meta.(type) {
 	case *Object:
+		if m.hasProperty(TEST_ITEM_META__NAME_PROPNAME) {
+			if strLike, ok := m.Prop(TEST_ITEM_META__NAME_PROPNAME).(StringLike); ok {
+				name = strLike.GetOrBuildString()
+			}
+		}
+
 		hasMainDatabaseSchema := m.hasProperty(TEST_ITEM_META__MAIN_DB_SCHEMA)
 		hasMainDatabaseMigrations := m.hasProperty(TEST_ITEM_META__MAIN_DB_MIGRATIONS)
 		hasProgram := m.hasProperty(TEST_ITEM_META__PROGRAM_PROPNAME)
@@ -225,13 +276,14 @@ func checkTestItemMeta(node parse.Node, state *State, isTestCase bool) (currentT
 		if program.hasValue {
 			info, err := state.projectFilesystem.Stat(program.value)
 			if err != nil {
-				return nil, nil, fmt.Errorf("failed to get info of file %s: %w", program.value, err)
+				return nil, nil, nil, fmt.Errorf("failed to get info of file %s: %w", program.value, err)
 			}
 			if !info.Mode().IsRegular() {
 				state.addError(makeSymbolicEvalError(node, state, fmtNotRegularFile(program.value)))
 			}
 		}
 	case StringLike:
+		name = m.GetOrBuildString()
 
 		//inherit tested program
 		if parentTestedProgram != nil {
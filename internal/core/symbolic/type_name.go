@@ -0,0 +1,93 @@
+package symbolic
+
+import (
+	"reflect"
+	"strings"
+	"unicode"
+
+	"github.com/inoxlang/inox/internal/core/patternnames"
+)
+
+var (
+	_ = []ValueWithTypeName{
+		(*NilT)(nil), (*DateTime)(nil), (*Dictionary)(nil), (*ByteSlice)(nil), (*UUIDv4)(nil),
+		(*LThread)(nil), (*LThreadGroup)(nil), (*TreedataHiearchyEntry)(nil),
+	}
+)
+
+// A ValueWithTypeName is a Value that overrides the type name derived from its Go type, see TypeName.
+type ValueWithTypeName interface {
+	Value
+	TypeName() string
+}
+
+// TypeName returns a stable name identifying the kind of v, the name does not depend on the exact value
+// (e.g. the type name of 1 and %int are both "int"). If v does not implement ValueWithTypeName a name is
+// derived from its Go type: *symbolic.ByteCount -> "byte-count". The empty string is returned if v is nil.
+func TypeName(v Value) string {
+	if v == nil {
+		return ""
+	}
+
+	if v, ok := v.(ValueWithTypeName); ok {
+		return v.TypeName()
+	}
+
+	t := reflect.TypeOf(v)
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+	return typeNameFromGoTypeName(t.Name())
+}
+
+// typeNameFromGoTypeName converts a Go type name in pascal case to kebab case: URLPattern -> url-pattern.
+func typeNameFromGoTypeName(name string) string {
+	runes := []rune(name)
+	builder := strings.Builder{}
+
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				builder.WriteRune('-')
+			}
+		}
+		builder.WriteRune(unicode.ToLower(r))
+	}
+
+	return builder.String()
+}
+
+func (*NilT) TypeName() string {
+	return patternnames.NIL
+}
+
+func (*DateTime) TypeName() string {
+	return patternnames.DATETIME
+}
+
+func (*Dictionary) TypeName() string {
+	return patternnames.DICT
+}
+
+func (*ByteSlice) TypeName() string {
+	return patternnames.BYTES
+}
+
+func (*UUIDv4) TypeName() string {
+	return "uuidv4"
+}
+
+func (*LThread) TypeName() string {
+	return "lthread"
+}
+
+func (*LThreadGroup) TypeName() string {
+	return "lthread-group"
+}
+
+func (*TreedataHiearchyEntry) TypeName() string {
+	return "treedata-hierarchy-entry"
+}
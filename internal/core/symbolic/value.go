@@ -451,7 +451,7 @@ func (o *Option) Test(v Value, state RecTestCallState) bool {
 		return false
 	}
 
-	return o.value.Test(otherOpt.value, RecTestCallState{})
+	return o.value.Test(otherOpt.value, state)
 }
 
 func (o *Option) IsConcretizable() bool {
@@ -784,8 +784,10 @@ type IntRange struct {
 
 	//fields set if .hasValue is true
 
+	unknownStart bool //if true .start is not set (upper-bound range such as ..10)
 	start        *Int
 	end          *Int
+	step         *Int //set if the step is known and not 1
 	isStepNotOne bool //only symbolic int ranges with a step of 1 are fully supported
 }
 
@@ -805,6 +807,36 @@ func NewIntRange(start, end *Int, isStepNotOne bool) *IntRange {
 	}
 }
 
+// NewUpperBoundIntRange creates an IntRange with an unknown start and an inclusive end (e.g. ..10).
+func NewUpperBoundIntRange(end *Int) *IntRange {
+	if !end.hasValue {
+		panic(errors.New("upper bound has no value"))
+	}
+
+	return &IntRange{
+		hasValue:     true,
+		unknownStart: true,
+		end:          end,
+	}
+}
+
+// NewSteppedIntRange creates an IntRange with a known step, step should have a value greater than zero.
+func NewSteppedIntRange(start, end, step *Int) *IntRange {
+	if !step.hasValue {
+		panic(errors.New("step has no value"))
+	}
+	if step.value <= 0 {
+		panic(errors.New("step should be greater than zero"))
+	}
+	if step.value == 1 {
+		return NewIntRange(start, end, false)
+	}
+
+	r := NewIntRange(start, end, true)
+	r.step = step
+	return r
+}
+
 func (r *IntRange) Test(v Value, state RecTestCallState) bool {
 	state.StartCall()
 	defer state.FinishCall()
@@ -821,9 +853,15 @@ func (r *IntRange) Test(v Value, state RecTestCallState) bool {
 		return false
 	} //else boh ranges have a value
 
-	return r.isStepNotOne == otherRange.isStepNotOne &&
-		r.start == otherRange.start &&
-		r.end == otherRange.end
+	if (r.step == nil) != (otherRange.step == nil) || (r.step != nil && r.step.value != otherRange.step.value) {
+		return false
+	}
+
+	if r.unknownStart != otherRange.unknownStart || (!r.unknownStart && r.start.value != otherRange.start.value) {
+		return false
+	}
+
+	return r.isStepNotOne == otherRange.isStepNotOne && r.end.value == otherRange.end.value
 }
 
 func (r *IntRange) Static() Pattern {
@@ -836,9 +874,15 @@ func (r *IntRange) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.Pretty
 		return
 	}
 
-	w.WriteStringF("%d..%d", r.start.value, r.end.value)
+	if r.unknownStart {
+		w.WriteStringF("..%d", r.end.value)
+	} else {
+		w.WriteStringF("%d..%d", r.start.value, r.end.value)
+	}
 
-	if r.isStepNotOne {
+	if r.step != nil {
+		w.WriteStringF(" step %d", r.step.value)
+	} else if r.isStepNotOne {
 		w.WriteString("(step?)")
 	}
 }
@@ -848,6 +892,10 @@ func (r *IntRange) KnownLen() int {
 }
 
 func (r *IntRange) Element() Value {
+	if r.hasValue && !r.unknownStart && !r.isStepNotOne && r.start.value == r.end.value {
+		//single-element range
+		return NewInt(r.start.value)
+	}
 	return &Int{
 		hasValue:        false,
 		matchingPattern: &IntRangePattern{intRange: r},
@@ -876,7 +924,12 @@ func (r *IntRange) Contains(value Serializable) (yes bool, possible bool) {
 		return false, true
 	}
 
-	contained := int.value >= r.start.value && int.value <= r.InclusiveEnd()
+	contained := (r.unknownStart || int.value >= r.start.value) && int.value <= r.InclusiveEnd()
+
+	if contained && r.step != nil {
+		contained = (int.value-r.start.value)%r.step.value == 0
+		return contained, contained
+	}
 
 	if contained && r.isStepNotOne {
 		return false, true
@@ -908,11 +961,26 @@ type FloatRange struct {
 
 	//fields set if .hasValue is true
 
+	unknownStart bool //if true .start is not set (upper-bound range such as ..1.0)
 	inclusiveEnd bool
 	start        *Float
 	end          *Float
 }
 
+// NewUpperBoundFloatRange creates a FloatRange with an unknown start (e.g. ..1.0).
+func NewUpperBoundFloatRange(end *Float, inclusiveEnd bool) *FloatRange {
+	if !end.hasValue {
+		panic(errors.New("upper bound has no value"))
+	}
+
+	return &FloatRange{
+		hasValue:     true,
+		unknownStart: true,
+		inclusiveEnd: inclusiveEnd,
+		end:          end,
+	}
+}
+
 func NewIncludedEndFloatRange(start, end *Float) *FloatRange {
 	if !start.hasValue {
 		panic(errors.New("lower bound has no value"))
@@ -977,9 +1045,11 @@ func (r *FloatRange) Test(v Value, state RecTestCallState) bool {
 		return false
 	} //else boh ranges have a value
 
-	return r.start == otherRange.start &&
-		r.end == otherRange.end &&
-		r.inclusiveEnd == otherRange.inclusiveEnd
+	if r.unknownStart != otherRange.unknownStart || (!r.unknownStart && r.start.value != otherRange.start.value) {
+		return false
+	}
+
+	return r.end.value == otherRange.end.value && r.inclusiveEnd == otherRange.inclusiveEnd
 }
 
 func (r *FloatRange) Static() Pattern {
@@ -993,10 +1063,12 @@ func (r *FloatRange) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.Pret
 	}
 
 	//print start
-	s := strconv.FormatFloat(r.start.value, 'g', -1, 64)
-	w.WriteString(s)
-	if !strings.ContainsAny(s, ".e") {
-		w.WriteString(".0")
+	if !r.unknownStart {
+		s := strconv.FormatFloat(r.start.value, 'g', -1, 64)
+		w.WriteString(s)
+		if !strings.ContainsAny(s, ".e") {
+			w.WriteString(".0")
+		}
 	}
 
 	if r.inclusiveEnd {
@@ -1006,7 +1078,7 @@ func (r *FloatRange) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.Pret
 	}
 
 	//print end
-	s = strconv.FormatFloat(r.end.value, 'g', -1, 64)
+	s := strconv.FormatFloat(r.end.value, 'g', -1, 64)
 	w.WriteString(s)
 	if !strings.ContainsAny(s, ".e") {
 		w.WriteString(".0")
@@ -1034,7 +1106,7 @@ func (r *FloatRange) Contains(value Serializable) (yes bool, possible bool) {
 		return false, true
 	}
 
-	contained := float.value >= r.start.value && float.value <= r.InclusiveEnd()
+	contained := (r.unknownStart || float.value >= r.start.value) && float.value <= r.InclusiveEnd()
 	return contained, contained
 }
 
@@ -1492,7 +1564,7 @@ func (p *Port) WidestOfType() Value {
 
 // A Treedata represents a symbolic Treedata.
 type Treedata struct {
-	_ int
+	nodeValue Value //union of the values of all nodes, nil if any
 	SerializableMixin
 }
 
@@ -1500,12 +1572,21 @@ func (i *Treedata) Test(v Value, state RecTestCallState) bool {
 	state.StartCall()
 	defer state.FinishCall()
 
-	_, ok := v.(*Treedata)
-	return ok
+	other, ok := v.(*Treedata)
+	if !ok {
+		return false
+	}
+	if i.nodeValue == nil {
+		return true
+	}
+	return other.nodeValue != nil && i.nodeValue.Test(other.nodeValue, state)
 }
 
-func (*Treedata) WalkerElement() Value {
-	return ANY
+func (i *Treedata) WalkerElement() Value {
+	if i.nodeValue == nil {
+		return ANY
+	}
+	return i.nodeValue
 }
 
 func (*Treedata) WalkerNodeMeta() Value {
@@ -1520,17 +1601,23 @@ func (i *Treedata) WidestOfType() Value {
 	return ANY_TREEDATA
 }
 
-// A TreedataHiearchyEntry represents a symbolic Treedata.
+// A TreedataHiearchyEntry represents a symbolic TreedataHiearchyEntry.
 type TreedataHiearchyEntry struct {
-	_ int
+	nodeValue Value //union of the values of the entry and its descendants, nil if any
 }
 
 func (i *TreedataHiearchyEntry) Test(v Value, state RecTestCallState) bool {
 	state.StartCall()
 	defer state.FinishCall()
 
-	_, ok := v.(*TreedataHiearchyEntry)
-	return ok
+	other, ok := v.(*TreedataHiearchyEntry)
+	if !ok {
+		return false
+	}
+	if i.nodeValue == nil {
+		return true
+	}
+	return other.nodeValue != nil && i.nodeValue.Test(other.nodeValue, state)
 }
 
 func (i *TreedataHiearchyEntry) PrettyPrint(w pprint.PrettyPrintWriter, config *pprint.PrettyPrintConfig) {
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/inoxlang/inox/internal/parse"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestSymbolicAny(t *testing.T) {
@@ -17,6 +18,29 @@ func TestSymbolicAny(t *testing.T) {
 
 }
 
+func TestTypeName(t *testing.T) {
+	assert.Equal(t, "any", TypeName(ANY))
+	assert.Equal(t, "nil", TypeName(Nil))
+	assert.Equal(t, "bool", TypeName(ANY_BOOL))
+	assert.Equal(t, "bool", TypeName(TRUE))
+	assert.Equal(t, "int", TypeName(ANY_INT))
+	assert.Equal(t, "int", TypeName(INT_1))
+	assert.Equal(t, "float", TypeName(ANY_FLOAT))
+	assert.Equal(t, "string", TypeName(NewString("a")))
+	assert.Equal(t, "list", TypeName(NewListOf(ANY_INT)))
+	assert.Equal(t, "object", TypeName(NewInexactObject2(map[string]Serializable{"a": ANY_INT})))
+	assert.Equal(t, "record", TypeName(ANY_REC))
+	assert.Equal(t, "bytes", TypeName(ANY_BYTE_SLICE))
+
+	assert.Equal(t, "datetime", TypeName(ANY_DATETIME))
+	assert.Equal(t, "uuidv4", TypeName(ANY_UUIDv4))
+
+	//name derived from the Go type
+	assert.Equal(t, "byte-count", TypeName(ANY_BYTECOUNT))
+
+	assert.Equal(t, "", TypeName(nil))
+}
+
 func TestSymbolicNil(t *testing.T) {
 
 	t.Run("Test()", func(t *testing.T) {
@@ -239,6 +263,20 @@ func TestSymbolicIntRange(t *testing.T) {
 		assertTestFalse(t, intRange1_2UnsupportedStep, ANY_INT)
 	})
 
+	t.Run("Test() with upper-bound ranges", func(t *testing.T) {
+		upperBound2 := NewUpperBoundIntRange(INT_2)
+		otherUpperBound2 := NewUpperBoundIntRange(NewInt(2))
+		upperBound3 := NewUpperBoundIntRange(INT_3)
+
+		assertTest(t, ANY_INT_RANGE, upperBound2)
+		assertTest(t, upperBound2, upperBound2)
+		assertTest(t, upperBound2, otherUpperBound2)
+		assertTestFalse(t, upperBound2, upperBound3)
+		assertTestFalse(t, upperBound2, ANY_INT_RANGE)
+		assertTestFalse(t, upperBound2, NewIntRange(INT_1, INT_2, false))
+		assertTestFalse(t, NewIntRange(INT_1, INT_2, false), upperBound2)
+	})
+
 	t.Run("Contains()", func(t *testing.T) {
 		anyIntRange := &IntRange{}
 		assertMayContainButNotCertain(t, anyIntRange, INT_0)
@@ -256,6 +294,11 @@ func TestSymbolicIntRange(t *testing.T) {
 		assertMayContainButNotCertain(t, intRangeUnsupportedStep, INT_2)
 		assertCannotPossiblyContain(t, intRange1_2, INT_0)
 		assertCannotPossiblyContain(t, intRange1_2, INT_3)
+
+		upperBound2 := NewUpperBoundIntRange(INT_2)
+		assertContains(t, upperBound2, NewInt(-10))
+		assertContains(t, upperBound2, INT_2)
+		assertCannotPossiblyContain(t, upperBound2, INT_3)
 	})
 
 }
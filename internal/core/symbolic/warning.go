@@ -2,8 +2,18 @@ package symbolic
 
 import "github.com/inoxlang/inox/internal/parse"
 
+var _ parse.LocatedError = SymbolicEvaluationWarning{}
+
 type SymbolicEvaluationWarning struct {
 	Message        string
 	LocatedMessage string
 	Location       parse.SourcePositionStack
 }
+
+func (w SymbolicEvaluationWarning) MessageWithoutLocation() string {
+	return w.Message
+}
+
+func (w SymbolicEvaluationWarning) LocationStack() parse.SourcePositionStack {
+	return w.Location
+}
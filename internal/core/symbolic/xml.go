@@ -14,17 +14,30 @@ const (
 var (
 	ANY_XML_ELEM = &XMLElement{}
 
+	XML_ELEMENT_PROPNAMES = []string{"children"}
+
 	xmlInterpolationCheckingFunctions = map[uintptr] /* go symbolic function pointer*/ XMLInterpolationCheckingFunction{}
+	xmlAttributeCheckingFunctions     = map[uintptr] /* go symbolic function pointer*/ XMLAttributeCheckingFunction{}
 )
 
 type XMLInterpolationCheckingFunction func(n parse.Node, value Value) (errorMsg string)
 
+// A XMLAttributeCheckingFunction checks the value of an attribute, n is the node of the value.
+type XMLAttributeCheckingFunction func(n parse.Node, elementName string, attrName string, value Value) (errorMsg string)
+
 func RegisterXMLInterpolationCheckingFunction(factory any, fn XMLInterpolationCheckingFunction) {
 	xmlInterpolationCheckingFunctions[reflect.ValueOf(factory).Pointer()] = fn
 }
 
+func RegisterXMLAttributeCheckingFunction(factory any, fn XMLAttributeCheckingFunction) {
+	xmlAttributeCheckingFunctions[reflect.ValueOf(factory).Pointer()] = fn
+}
+
+// UnregisterXMLCheckingFunction unregisters the interpolation and attribute checking functions of factory.
 func UnregisterXMLCheckingFunction(factory any) {
-	delete(xmlInterpolationCheckingFunctions, reflect.ValueOf(factory).Pointer())
+	ptr := reflect.ValueOf(factory).Pointer()
+	delete(xmlInterpolationCheckingFunctions, ptr)
+	delete(xmlAttributeCheckingFunctions, ptr)
 }
 
 // A XMLElement represents a symbolic XMLElement.
@@ -32,6 +45,8 @@ type XMLElement struct {
 	name       string //if "" matches any node value
 	attributes map[string]Value
 	children   []Value
+
+	UnassignablePropsMixin
 }
 
 func NewXmlElement(name string, attributes map[string]Value, children []Value) *XMLElement {
@@ -52,6 +67,21 @@ func (e *XMLElement) Children() []Value {
 	return e.children
 }
 
+func (e *XMLElement) Prop(name string) Value {
+	switch name {
+	case "children":
+		if e.name == "" {
+			return NewArrayOf(ANY)
+		}
+		return NewArray(e.children...)
+	}
+	panic(FormatErrPropertyDoesNotExist(name, e))
+}
+
+func (*XMLElement) PropertyNames() []string {
+	return XML_ELEMENT_PROPNAMES
+}
+
 func (r *XMLElement) Test(v Value, state RecTestCallState) bool {
 	state.StartCall()
 	defer state.FinishCall()
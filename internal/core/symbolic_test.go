@@ -1,6 +1,7 @@
 package core
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/inoxlang/inox/internal/core/permkind"
@@ -112,6 +113,37 @@ func TestSymbolicEvalCheck(t *testing.T) {
 		assert.Contains(t, symbolic.POSSIBLE_MISSING_PERM_TO_CREATE_A_LTHREAD, warning.Message)
 	})
 
+	t.Run("warnings as errors", func(t *testing.T) {
+		code := `go {globals: {global2: 2}} do { return (global1 + global2)}`
+		chunk := utils.Must(parse.ParseChunkSource(parse.InMemorySource{
+			NameString: "symbolic-core-test",
+			CodeString: code,
+		}))
+
+		mod := &Module{MainChunk: chunk, TopLevelNode: chunk.Node}
+
+		data, err := symbolic.EvalCheck(symbolic.EvalCheckInput{
+			Node:   chunk.Node,
+			Module: mod.ToSymbolic(),
+			Globals: map[string]symbolic.ConcreteGlobalValue{
+				"global1": {Value: Int(1), IsConstant: true},
+			},
+			Context:          symbolic.NewSymbolicContext(noPermsCtx, nil, nil),
+			WarningsAsErrors: true,
+		})
+
+		if !assert.Error(t, err) {
+			return
+		}
+		assert.Contains(t, err.Error(), symbolic.POSSIBLE_MISSING_PERM_TO_CREATE_A_LTHREAD)
+
+		if !assert.NotNil(t, data) {
+			return
+		}
+		assert.Empty(t, data.Errors())
+		assert.NotEmpty(t, data.Warnings())
+	})
+
 	t.Run("spawn expression within embedded module (missing permission)", func(t *testing.T) {
 		code := `go {allow: {}} do {   go do {}  }`
 
@@ -150,6 +182,125 @@ func TestSymbolicEvalCheck(t *testing.T) {
 			Span:        parse.NodeSpan{Start: 22, End: 24},
 		}, warning.Location[0])
 	})
+
+	t.Run("spawn expression within embedded module without allow section (missing permission)", func(t *testing.T) {
+		code := `go do {   go do {}  }`
+
+		chunk := utils.Must(parse.ParseChunkSource(parse.InMemorySource{
+			NameString: "symbolic-core-test",
+			CodeString: code,
+		}))
+
+		mod := &Module{MainChunk: chunk, TopLevelNode: chunk.Node}
+
+		ctx := NewContext(ContextConfig{
+			Permissions: []Permission{LThreadPermission{Kind_: permkind.Create}},
+		})
+		defer ctx.CancelGracefully()
+
+		data, err := symbolic.EvalCheck(symbolic.EvalCheckInput{
+			Node:    chunk.Node,
+			Module:  mod.ToSymbolic(),
+			Globals: map[string]symbolic.ConcreteGlobalValue{},
+			Context: symbolic.NewSymbolicContext(ctx, nil, nil),
+		})
+
+		//the permission to create lthreads is implicitly removed from the embedded module.
+		assert.NoError(t, err)
+		assert.Empty(t, data.Errors())
+		if !assert.Len(t, data.Warnings(), 1) {
+			return
+		}
+		warning := data.Warnings()[0]
+		assert.Contains(t, symbolic.POSSIBLE_MISSING_PERM_TO_CREATE_A_LTHREAD, warning.Message)
+		assert.Equal(t, parse.NodeSpan{Start: 10, End: 12}, warning.Location[0].Span)
+	})
+
+	t.Run("spawn expression with an allow section that is not an object literal", func(t *testing.T) {
+		code := "perms = {read: %/...}\ngo {allow: perms} do {}"
+
+		chunk := utils.Must(parse.ParseChunkSource(parse.InMemorySource{
+			NameString: "symbolic-core-test",
+			CodeString: code,
+		}))
+
+		mod := &Module{MainChunk: chunk, TopLevelNode: chunk.Node}
+
+		ctx := NewContext(ContextConfig{
+			Permissions: []Permission{LThreadPermission{Kind_: permkind.Create}},
+		})
+		defer ctx.CancelGracefully()
+
+		data, err := symbolic.EvalCheck(symbolic.EvalCheckInput{
+			Node:    chunk.Node,
+			Module:  mod.ToSymbolic(),
+			Globals: map[string]symbolic.ConcreteGlobalValue{},
+			Context: symbolic.NewSymbolicContext(ctx, nil, nil),
+		})
+
+		assert.NoError(t, err)
+		assert.Empty(t, data.Errors())
+		assert.Empty(t, data.Warnings())
+	})
+}
+
+type customSymbolicConversionTestValue struct {
+	Int
+}
+
+func init() {
+	RegisterSymbolicConverter(reflect.TypeOf(customSymbolicConversionTestValue{}), func(v any) (symbolic.Value, error) {
+		return symbolic.NewString("custom"), nil
+	})
+}
+
+func TestRegisterSymbolicConverter(t *testing.T) {
+	ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+	defer ctx.CancelGracefully()
+
+	t.Run("ToSymbolicValue should use the registered converter", func(t *testing.T) {
+		v, err := ToSymbolicValue(ctx, customSymbolicConversionTestValue{Int: 1}, false)
+		if assert.NoError(t, err) {
+			assert.Equal(t, symbolic.NewString("custom"), v)
+		}
+	})
+
+	t.Run("registering a second converter for the same type should panic", func(t *testing.T) {
+		assert.PanicsWithError(t, ErrNonUniqueSymbolicConverterRegistration.Error()+": core.customSymbolicConversionTestValue", func() {
+			RegisterSymbolicConverter(reflect.TypeOf(customSymbolicConversionTestValue{}), func(v any) (symbolic.Value, error) {
+				return symbolic.ANY, nil
+			})
+		})
+	})
+
+	t.Run("global passed to EvalCheck", func(t *testing.T) {
+		code := `return $$var`
+		chunk := utils.Must(parse.ParseChunkSource(parse.InMemorySource{
+			NameString: "symbolic-core-test",
+			CodeString: code,
+		}))
+
+		mod := &Module{MainChunk: chunk, TopLevelNode: chunk.Node}
+
+		data, err := symbolic.EvalCheck(symbolic.EvalCheckInput{
+			Node:   chunk.Node,
+			Module: mod.ToSymbolic(),
+			Globals: map[string]symbolic.ConcreteGlobalValue{
+				"var": {Value: customSymbolicConversionTestValue{Int: 1}, IsConstant: true},
+			},
+			Context: symbolic.NewSymbolicContext(ctx, nil, nil),
+		})
+
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		globalVar := parse.FindNode(chunk.Node, (*parse.GlobalVariable)(nil), nil)
+		value, ok := data.GetMostSpecificNodeValue(globalVar)
+		if assert.True(t, ok) {
+			assert.Equal(t, symbolic.NewString("custom"), value)
+		}
+	})
 }
 
 func TestBidirectionalSymbolicConversion(t *testing.T) {
@@ -336,6 +487,33 @@ func TestBidirectionalSymbolicConversion(t *testing.T) {
 		assert.Equal(t, pair, concreteValue)
 	})
 
+	t.Run("float range with an unknown start", func(t *testing.T) {
+		t.Run("inclusive end", func(t *testing.T) {
+			floatRange := NewUnknownStartFloatRange(10, true)
+			v, err := ToSymbolicValue(nil, floatRange, false)
+			assert.NoError(t, err)
+
+			assert.Equal(t, symbolic.NewUpperBoundFloatRange(symbolic.NewFloat(10), true), v)
+
+			contained, _ := v.(*symbolic.FloatRange).Contains(symbolic.NewFloat(10))
+			assert.True(t, contained)
+		})
+
+		t.Run("exclusive end", func(t *testing.T) {
+			floatRange := NewUnknownStartFloatRange(10, false)
+			v, err := ToSymbolicValue(nil, floatRange, false)
+			assert.NoError(t, err)
+
+			assert.Equal(t, symbolic.NewUpperBoundFloatRange(symbolic.NewFloat(10), false), v)
+
+			contained, _ := v.(*symbolic.FloatRange).Contains(symbolic.NewFloat(10))
+			assert.False(t, contained)
+
+			contained, _ = v.(*symbolic.FloatRange).Contains(symbolic.NewFloat(9.5))
+			assert.True(t, contained)
+		})
+	})
+
 	t.Run("dictionary", func(t *testing.T) {
 		t.Run("empty", func(t *testing.T) {
 			ctx := NewContextWithEmptyState(ContextConfig{}, nil)
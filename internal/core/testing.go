@@ -253,6 +253,9 @@ func (s *TestSuite) GetGoMethod(name string) (*GoFunction, bool) {
 }
 
 func (s *TestSuite) Prop(ctx *Context, name string) Value {
+	if name == symbolic.TEST_ITEM__NAME_PROPNAME {
+		return String(s.nameFrom)
+	}
 	method, ok := s.GetGoMethod(name)
 	if !ok {
 		panic(FormatErrPropertyDoesNotExist(name, s))
@@ -265,7 +268,7 @@ func (*TestSuite) SetProp(ctx *Context, name string, value Value) error {
 }
 
 func (*TestSuite) PropertyNames(ctx *Context) []string {
-	return []string{"run"}
+	return symbolic.TEST_ITEM_PROPNAMES
 }
 
 // A TestCase represents a test case, TestCase implements Value.
@@ -512,6 +515,9 @@ func (s *TestCase) GetGoMethod(name string) (*GoFunction, bool) {
 }
 
 func (s *TestCase) Prop(ctx *Context, name string) Value {
+	if name == symbolic.TEST_ITEM__NAME_PROPNAME {
+		return String(s.name)
+	}
 	method, ok := s.GetGoMethod(name)
 	if !ok {
 		panic(FormatErrPropertyDoesNotExist(name, s))
@@ -524,7 +530,7 @@ func (*TestCase) SetProp(ctx *Context, name string, value Value) error {
 }
 
 func (*TestCase) PropertyNames(ctx *Context) []string {
-	return []string{"run"}
+	return symbolic.TEST_ITEM_PROPNAMES
 }
 
 func runTestItem(
@@ -632,6 +632,34 @@ func TreeWalkEval(node parse.Node, state *TreeWalkState) (result Value, err erro
 			}
 
 			return nil, storeValue(right)
+		case *parse.ComputedMemberExpression:
+			left, err := TreeWalkEval(lhs.Left, state)
+			if err != nil {
+				return nil, err
+			}
+
+			propName, err := TreeWalkEval(lhs.PropertyName, state)
+			if err != nil {
+				return nil, err
+			}
+			key := propName.(StringLike).GetOrBuildString()
+
+			right, err := TreeWalkEval(n.Right, state)
+			if err != nil {
+				return nil, err
+			}
+
+			iprops := left.(IProps)
+			getLeft := func() Value {
+				return iprops.Prop(state.Global.Ctx, key)
+			}
+
+			right, err = handleAssignmentOperation(getLeft, right)
+			if err != nil {
+				return nil, err
+			}
+
+			return nil, iprops.SetProp(state.Global.Ctx, key, right)
 		case *parse.IdentifierMemberExpression:
 			left, err := TreeWalkEval(lhs.Left, state)
 			if err != nil {
@@ -774,7 +802,7 @@ func TreeWalkEval(node parse.Node, state *TreeWalkState) (result Value, err erro
 			return nil, err
 		}
 
-		list := right.(Sequence)
+		list := right.(Indexable)
 		scope := state.CurrentLocalScope()
 
 		listLength := list.Len()
@@ -2025,11 +2053,17 @@ func TreeWalkEval(node parse.Node, state *TreeWalkState) (result Value, err erro
 			upperBound = n.UpperBound.(*parse.IntLiteral).Value
 		}
 
+		step := int64(1)
+
+		if n.Step != nil {
+			step = n.Step.(*parse.IntLiteral).Value
+		}
+
 		return IntRange{
 			unknownStart: false,
 			start:        n.LowerBound.Value,
 			end:          upperBound,
-			step:         1,
+			step:         step,
 		}, nil
 	case *parse.FloatRangeLiteral:
 		upperBound := float64(math.MaxFloat64)
@@ -2418,22 +2452,7 @@ func TreeWalkEval(node parse.Node, state *TreeWalkState) (result Value, err erro
 				return nil, err
 			}
 
-			spreadPattern := evaluatedElement.(*ObjectPattern)
-
-			for _, entry := range spreadPattern.entries {
-				//priority to property pattern defined earlier.
-				if pattern.HasRequiredOrOptionalEntry(entry.Name) {
-					//already present.
-					continue
-				}
-
-				pattern.entries = append(pattern.entries, ObjectPatternEntry{
-					Name:       entry.Name,
-					Pattern:    entry.Pattern,
-					IsOptional: entry.IsOptional,
-					//ignore dependencies
-				})
-			}
+			pattern.addSpreadEntries(evaluatedElement.(Pattern))
 		}
 
 		pattern.init()
@@ -2464,21 +2483,7 @@ func TreeWalkEval(node parse.Node, state *TreeWalkState) (result Value, err erro
 				return nil, err
 			}
 
-			spreadPattern := evaluatedElement.(*RecordPattern)
-
-			for _, entry := range spreadPattern.entries {
-				//priority to property pattern defined earlier.
-				if pattern.HasRequiredOrOptionalEntry(entry.Name) {
-					//already present.
-					continue
-				}
-
-				pattern.entries = append(pattern.entries, RecordPatternEntry{
-					Name:       entry.Name,
-					Pattern:    entry.Pattern,
-					IsOptional: entry.IsOptional,
-				})
-			}
+			pattern.addSpreadEntries(evaluatedElement.(Pattern))
 		}
 
 		pattern.init()
@@ -2586,6 +2591,9 @@ func TreeWalkEval(node parse.Node, state *TreeWalkState) (result Value, err erro
 		case *Tuple:
 			tuples := utils.MapSlice(values, func(e Value) *Tuple { return e.(*Tuple) })
 			return ConcatTuples(tuples...), nil
+		case *List:
+			lists := utils.MapSlice(values, func(e Value) *List { return e.(*List) })
+			return ConcatLists(ctx, lists...), nil
 		default:
 			return nil, fmt.Errorf("unsupported type")
 		}
@@ -3338,6 +3346,15 @@ func evalBinaryExpression(n *parse.BinaryExpression, state *TreeWalkState) (Valu
 		return Bool(ok), nil
 	case parse.Substrof:
 		return Bool(isSubstrOf(state.Global.Ctx, left, right)), nil
+	case parse.As:
+		pattern, ok := right.(Pattern)
+		if !ok {
+			return nil, ErrRightOperandOfAsNotPattern
+		}
+		if !pattern.Test(state.Global.Ctx, left) {
+			return nil, FormatAsConversionFailed(pattern, state.Global.Ctx)
+		}
+		return left, nil
 	case parse.SetDifference:
 		if _, ok := right.(Pattern); !ok {
 			right = NewExactValuePattern(right.(Serializable))
@@ -3,6 +3,7 @@ package core
 import (
 	"errors"
 	"slices"
+	"sort"
 
 	"github.com/bits-and-blooms/bitset"
 	"github.com/inoxlang/inox/internal/utils"
@@ -25,6 +26,16 @@ type underlyingList interface {
 	ContainsSimple(ctx *Context, v Serializable) bool
 	append(ctx *Context, values ...Serializable)
 	removeAll(ctx *Context, filter Pattern) (removedPositions []Int)
+
+	// insertSorted inserts v after all elements that are not greater than v according to less,
+	// the list is expected to be sorted. The insertion index is returned.
+	insertSorted(ctx *Context, v Serializable, less func(a, b Serializable) bool) (index Int)
+
+	// reverse reverses the order of the elements in place.
+	reverse(ctx *Context)
+
+	// dedup returns a new list of the same kind without duplicate elements, the first occurrence of each element is kept.
+	dedup(ctx *Context) underlyingList
 	ConstraintId() ConstraintId
 }
 
@@ -110,6 +121,38 @@ func (l *ValueList) insertElement(ctx *Context, v Value, i Int) {
 	}
 }
 
+func (l *ValueList) insertSorted(ctx *Context, v Serializable, less func(a, b Serializable) bool) Int {
+	return insertSortedInElements(ctx, l, l.elements, v, less)
+}
+
+// insertSortedInElements implements underlyingList.insertSorted for lists storing their elements in a slice.
+func insertSortedInElements[E Serializable](ctx *Context, l underlyingList, elements []E, v Serializable, less func(a, b Serializable) bool) Int {
+	index := Int(sort.Search(len(elements), func(i int) bool {
+		return less(v, elements[i])
+	}))
+	l.insertElement(ctx, v, index)
+	return index
+}
+
+func (l *ValueList) reverse(ctx *Context) {
+	slices.Reverse(l.elements)
+}
+
+func (l *ValueList) dedup(ctx *Context) underlyingList {
+	elements := make([]Serializable, 0, len(l.elements))
+
+outer:
+	for _, e := range l.elements {
+		for _, kept := range elements {
+			if e.Equal(ctx, kept, map[uintptr]uintptr{}, 0) {
+				continue outer
+			}
+		}
+		elements = append(elements, e)
+	}
+	return &ValueList{elements: elements}
+}
+
 func (l *ValueList) removePosition(ctx *Context, i Int) {
 	if int(i) != len(l.elements)-1 {
 		copy(l.elements[i:], l.elements[i+1:])
@@ -159,7 +202,27 @@ func (l *ValueList) insertSequence(ctx *Context, seq Sequence, i Int) {
 }
 
 func (l *ValueList) appendSequence(ctx *Context, seq Sequence) {
-	l.insertSequence(ctx, seq, Int(l.Len()))
+	if list, ok := seq.(*List); ok {
+		seq = list.underlyingList
+	}
+
+	if valueList, ok := seq.(*ValueList); ok {
+		l.appendN(ctx, valueList.elements)
+		return
+	}
+
+	seqLen := seq.Len()
+	l.elements = slices.Grow(l.elements, seqLen)
+
+	for i := 0; i < seqLen; i++ {
+		l.elements = append(l.elements, seq.At(ctx, i).(Serializable))
+	}
+}
+
+// appendN appends $values to the list, the underlying slice is grown at most once.
+func (l *ValueList) appendN(ctx *Context, values []Serializable) {
+	l.elements = slices.Grow(l.elements, len(values))
+	l.elements = append(l.elements, values...)
 }
 
 // NumberList implements underlyingList
@@ -269,6 +332,28 @@ func (l *NumberList[T]) insertElement(ctx *Context, v Value, i Int) {
 	}
 }
 
+func (l *NumberList[T]) insertSorted(ctx *Context, v Serializable, less func(a, b Serializable) bool) Int {
+	return insertSortedInElements(ctx, l, l.elements, v, less)
+}
+
+func (l *NumberList[T]) reverse(ctx *Context) {
+	slices.Reverse(l.elements)
+}
+
+func (l *NumberList[T]) dedup(ctx *Context) underlyingList {
+	elements := make([]T, 0, len(l.elements))
+	seen := make(map[T]struct{}, len(l.elements))
+
+	for _, e := range l.elements {
+		if _, ok := seen[e]; ok {
+			continue
+		}
+		seen[e] = struct{}{}
+		elements = append(elements, e)
+	}
+	return &NumberList[T]{elements: elements}
+}
+
 func (l *NumberList[T]) removePosition(ctx *Context, i Int) {
 	if int(i) != len(l.elements)-1 {
 		copy(l.elements[i:], l.elements[i+1:])
@@ -410,6 +495,29 @@ func (l *StringList) insertElement(ctx *Context, v Value, i Int) {
 	}
 }
 
+func (l *StringList) insertSorted(ctx *Context, v Serializable, less func(a, b Serializable) bool) Int {
+	return insertSortedInElements(ctx, l, l.elements, v, less)
+}
+
+func (l *StringList) reverse(ctx *Context) {
+	slices.Reverse(l.elements)
+}
+
+func (l *StringList) dedup(ctx *Context) underlyingList {
+	elements := make([]StringLike, 0, len(l.elements))
+	seen := make(map[string]struct{}, len(l.elements))
+
+	for _, e := range l.elements {
+		str := e.GetOrBuildString()
+		if _, ok := seen[str]; ok {
+			continue
+		}
+		seen[str] = struct{}{}
+		elements = append(elements, e)
+	}
+	return &StringList{elements: elements}
+}
+
 func (l *StringList) removePosition(ctx *Context, i Int) {
 	if int(i) != len(l.elements)-1 {
 		copy(l.elements[i:], l.elements[i+1:])
@@ -558,6 +666,41 @@ func (l *BoolList) insertElement(ctx *Context, v Value, i Int) {
 	l.set(ctx, int(i), v)
 }
 
+func (l *BoolList) insertSorted(ctx *Context, v Serializable, less func(a, b Serializable) bool) Int {
+	index := Int(sort.Search(l.Len(), func(i int) bool {
+		return less(v, Bool(l.BoolAt(i)))
+	}))
+	l.insertElement(ctx, v, index)
+	return index
+}
+
+func (l *BoolList) reverse(ctx *Context) {
+	length := l.elements.Len()
+
+	for i, j := uint(0), length-1; length > 0 && i < j; i, j = i+1, j-1 {
+		first := l.elements.Test(i)
+		l.elements.SetTo(i, l.elements.Test(j))
+		l.elements.SetTo(j, first)
+	}
+}
+
+func (l *BoolList) dedup(ctx *Context) underlyingList {
+	var elements []Bool
+	seenTrue, seenFalse := false, false
+
+	for i := 0; i < l.Len(); i++ {
+		boolean := l.BoolAt(i)
+		if boolean && !seenTrue {
+			seenTrue = true
+			elements = append(elements, True)
+		} else if !boolean && !seenFalse {
+			seenFalse = true
+			elements = append(elements, False)
+		}
+	}
+	return newBoolList(elements...)
+}
+
 func (l *BoolList) removePosition(ctx *Context, i Int) {
 	if i < 0 || i >= Int(l.elements.Len()) {
 		panic(ErrIndexOutOfRange)
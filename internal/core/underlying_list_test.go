@@ -21,11 +21,63 @@ func TestValueList(t *testing.T) {
 		elemB:   Int(2),
 		elemC:   Int(3),
 		elemD:   Int(4),
+		less: func(a, b Serializable) bool {
+			return a.(Int) < b.(Int)
+		},
 		getCapacity: func(ul underlyingList) int {
 			return len(ul.(*ValueList).elements)
 		},
 	})
 
+	t.Run("appendN", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		values := make([]Serializable, 10_000)
+		for i := range values {
+			values[i] = Int(i)
+		}
+
+		list := newValueList(Int(-1))
+		list.appendN(ctx, values)
+
+		assert.Equal(t, 10_001, list.Len())
+		assert.GreaterOrEqual(t, cap(list.elements), 10_001)
+		assert.Equal(t, Int(-1), list.At(ctx, 0))
+		assert.Equal(t, Int(9999), list.At(ctx, 10_000))
+
+		//no reallocation if the capacity is sufficient.
+		list = newValueList()
+		list.elements = make([]Serializable, 0, 10_000)
+		list.appendN(ctx, values)
+
+		assert.Equal(t, 10_000, cap(list.elements))
+	})
+
+	t.Run("appendSequence: reallocations", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		batch := make([]Serializable, 100)
+		for i := range batch {
+			batch[i] = Int(i)
+		}
+		seq := newValueList(batch...)
+
+		list := newValueList()
+		reallocations := 0
+
+		for i := 0; i < 100; i++ {
+			capacity := cap(list.elements)
+			list.appendSequence(ctx, seq)
+			if cap(list.elements) != capacity {
+				reallocations++
+			}
+		}
+
+		assert.Equal(t, 10_000, list.Len())
+		assert.Less(t, reallocations, 20)
+	})
 }
 
 func TestIntList(t *testing.T) {
@@ -41,6 +93,9 @@ func TestIntList(t *testing.T) {
 		elemB:   Int(2),
 		elemC:   Int(3),
 		elemD:   Int(4),
+		less: func(a, b Serializable) bool {
+			return a.(Int) < b.(Int)
+		},
 		getCapacity: func(ul underlyingList) int {
 			return len(ul.(*IntList).elements)
 		},
@@ -60,6 +115,9 @@ func TestStringList(t *testing.T) {
 		elemB:   String("b"),
 		elemC:   String("c"),
 		elemD:   String("d"),
+		less: func(a, b Serializable) bool {
+			return a.(StringLike).GetOrBuildString() < b.(StringLike).GetOrBuildString()
+		},
 		getCapacity: func(ul underlyingList) int {
 			return len(ul.(*StringList).elements)
 		},
@@ -86,6 +144,9 @@ type underlyingTestSuiteParams[E Serializable] struct {
 	newList                    func(elems ...E) underlyingList
 	elemA, elemB, elemC, elemD E
 
+	//if set insertSorted will be tested, elemA < elemB < elemC < elemD is expected.
+	less func(a, b Serializable) bool
+
 	//if set auto shrinking will be tested
 	getCapacity func(underlyingList) int
 }
@@ -106,6 +167,38 @@ func testUnderlyingList[E Serializable](t *testing.T, params underlyingTestSuite
 
 	//TODO: add more cases
 
+	t.Run("reverse", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		list := newList()
+		list.reverse(ctx)
+		assert.Empty(t, getAllElements(list))
+
+		list = newList(elemA, elemB)
+		list.reverse(ctx)
+		assert.Equal(t, []Value{elemB, elemA}, getAllElements(list))
+
+		list = newList(elemA, elemB, elemC)
+		list.reverse(ctx)
+		assert.Equal(t, []Value{elemC, elemB, elemA}, getAllElements(list))
+	})
+
+	t.Run("dedup", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		assert.Empty(t, getAllElements(newList().dedup(ctx)))
+
+		list := newList(elemA, elemB, elemA, elemB, elemA)
+		deduped := list.dedup(ctx)
+		assert.IsType(t, list, deduped)
+		assert.Equal(t, []Value{elemA, elemB}, getAllElements(deduped))
+
+		//the original list should not be modified.
+		assert.Equal(t, []Value{elemA, elemB, elemA, elemB, elemA}, getAllElements(list))
+	})
+
 	t.Run("set", func(t *testing.T) {
 		list := newList(elemA)
 		list.set(ctx, 0, elemB)
@@ -150,6 +243,23 @@ func testUnderlyingList[E Serializable](t *testing.T, params underlyingTestSuite
 		})
 	})
 
+	if params.less != nil {
+		t.Run("insertSorted", func(t *testing.T) {
+			ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+			list := newList()
+
+			assert.Equal(t, Int(0), list.insertSorted(ctx, elemC, params.less))
+			assert.Equal(t, Int(0), list.insertSorted(ctx, elemA, params.less))
+			assert.Equal(t, Int(2), list.insertSorted(ctx, elemD, params.less))
+			assert.Equal(t, Int(1), list.insertSorted(ctx, elemB, params.less))
+			assert.Equal(t, []Value{elemA, elemB, elemC, elemD}, getAllElements(list))
+
+			//an element equal to an existing one should be inserted after it.
+			assert.Equal(t, Int(3), list.insertSorted(ctx, elemC, params.less))
+			assert.Equal(t, []Value{elemA, elemB, elemC, elemC, elemD}, getAllElements(list))
+		})
+	}
+
 	t.Run("appendSequence", func(t *testing.T) {
 		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
 		list := newList(elemA)
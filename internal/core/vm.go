@@ -22,6 +22,7 @@ const (
 var (
 	ErrArgsProvidedToModule    = errors.New("cannot provide arguments when running module")
 	ErrInvalidProvidedArgCount = errors.New("number of provided arguments is invalid")
+	ErrConstantIndexOutOfRange = errors.New("constant index is out of range")
 
 	_ = parse.StackItem(frame{})
 )
@@ -42,6 +43,11 @@ type VM struct {
 	err              error
 	moduleLocalCount int
 
+	maxInstructions  uint64 //no limit if zero
+	instructionCount uint64
+	opcodeCounters   []uint64     //can be nil
+	executedIPs      map[int]bool //can be nil
+
 	chunkStack []*parse.ChunkStackItem
 
 	//the following fields are only set for isolated function calls.
@@ -85,6 +91,21 @@ type VMConfig struct {
 	State    *GlobalState
 	Self     Value
 
+	//maximum number of dispatched instructions during a run, no limit if zero.
+	MaxInstructions uint64
+
+	//if not nil the counter of each dispatched opcode is incremented (OpcodeCounters[opcode]),
+	//the length should be at least len(OpcodeNames).
+	OpcodeCounters []uint64
+
+	//if not nil the address of each executed instruction of the top function
+	//(main function or function called in isolation) is recorded.
+	ExecutedIPs map[int]bool
+
+	//if true the constant indexes of the instructions are checked before the execution (see VerifyConstantIndices)
+	//instead of causing a panic during the execution.
+	VerifyConstantIndices bool
+
 	//isolated call
 	Fn                 *InoxFunction
 	FnArgs             []Value
@@ -122,6 +143,16 @@ func NewVM(config VMConfig) (*VM, error) {
 		}
 	}
 
+	if config.OpcodeCounters != nil && len(config.OpcodeCounters) < len(OpcodeNames) {
+		return nil, fmt.Errorf("the length of the opcode counter slice should be at least %d", len(OpcodeNames))
+	}
+
+	if config.VerifyConstantIndices {
+		if err := bytecode.VerifyConstantIndices(); err != nil {
+			return nil, err
+		}
+	}
+
 	v := &VM{
 		global:             state,
 		constants:          bytecode.constants,
@@ -133,6 +164,9 @@ func NewVM(config VMConfig) (*VM, error) {
 		runFn:              runFn,
 		fnArgCount:         len(fnArgs),
 		disabledArgSharing: config.DisabledArgSharing,
+		maxInstructions:    config.MaxInstructions,
+		opcodeCounters:     config.OpcodeCounters,
+		executedIPs:        config.ExecutedIPs,
 	}
 	v.frames[0].fn = fn
 	v.frames[0].ip = -1
@@ -172,6 +206,7 @@ func (v *VM) Run() (result Value, err error) {
 	v.curInsts = v.curFrame.fn.Instructions
 	v.framesIndex = 1
 	v.ip = -1
+	v.instructionCount = 0
 
 	if v.runFn {
 		v.sp = 1 + v.fnArgCount + 1 + 1
@@ -297,6 +332,22 @@ func (v *VM) run() {
 
 		ip = v.ip
 
+		if v.maxInstructions != 0 {
+			v.instructionCount++
+			if v.instructionCount > v.maxInstructions {
+				v.err = ErrInstructionBudgetExceeded
+				return
+			}
+		}
+
+		if v.opcodeCounters != nil {
+			v.opcodeCounters[v.curInsts[ip]]++
+		}
+
+		if v.executedIPs != nil && v.framesIndex == 1 {
+			v.executedIPs[ip] = true
+		}
+
 		switch v.curInsts[ip] {
 		//STACK OPERATIONS AND CONSTANTS
 		case OpPushConstant:
@@ -628,6 +679,32 @@ func (v *VM) run() {
 			v.sp -= numElements
 			v.stack[v.sp] = ConcatTuples(tuples...)
 			v.sp++
+		case OpConcatLists:
+			v.ip += 4
+			numElements := int(v.curInsts[v.ip-2]) | int(v.curInsts[v.ip-3])<<8
+			spreadElemSetConstantIndex := int(v.curInsts[v.ip]) | int(v.curInsts[v.ip-1])<<8
+			spreadElemSet := v.constants[spreadElemSetConstantIndex].(*List).underlyingList.(*BoolList)
+
+			lists := make([]*List, 0, numElements)
+			ctx := v.global.Ctx
+
+			for i, v := range v.stack[v.sp-numElements : v.sp] {
+				if !spreadElemSet.BoolAt(i) {
+					lists = append(lists, v.(*List))
+					continue
+				}
+				//spread
+				iterable := v.(Iterable)
+				it := iterable.Iterator(ctx, IteratorConfiguration{})
+
+				for it.Next(ctx) {
+					lists = append(lists, it.Value(ctx).(*List))
+				}
+			}
+
+			v.sp -= numElements
+			v.stack[v.sp] = ConcatLists(ctx, lists...)
+			v.sp++
 		case OpRange:
 			right := v.stack[v.sp-1]
 			left := v.stack[v.sp-2]
@@ -719,6 +796,24 @@ func (v *VM) run() {
 				}
 			}
 
+			v.sp++
+		case OpAs:
+			left := v.stack[v.sp-2]
+			right := v.stack[v.sp-1]
+			v.sp -= 2
+
+			pattern, ok := right.(Pattern)
+			if !ok {
+				v.err = ErrRightOperandOfAsNotPattern
+				return
+			}
+
+			if !pattern.Test(v.global.Ctx, left) {
+				v.err = FormatAsConversionFailed(pattern, v.global.Ctx)
+				return
+			}
+
+			v.stack[v.sp] = left
 			v.sp++
 		case OpIn:
 			left := v.stack[v.sp-2]
@@ -802,6 +897,18 @@ func (v *VM) run() {
 			val := v.stack[v.curFrame.basePointer+localIndex]
 			v.stack[v.sp] = val
 			v.sp++
+		case OpIncLocal:
+			localIndex := int(v.curInsts[v.ip+1])
+			padding := int(v.curInsts[v.ip+2])
+			sp := v.curFrame.basePointer + localIndex
+
+			res, err := intAdd(v.stack[sp].(Int), 1)
+			if err != nil {
+				v.err = err
+				return
+			}
+			v.stack[sp] = res
+			v.ip += 2 + padding
 		case OpSetGlobal:
 			v.ip += 2
 			v.sp--
@@ -855,6 +962,16 @@ func (v *VM) run() {
 				v.err = err
 				return
 			}
+		case OpSetComputedMember:
+			iprops := v.stack[v.sp-3].(IProps)
+			memberName := v.stack[v.sp-2].(StringLike).GetOrBuildString()
+			val := v.stack[v.sp-1]
+			v.sp -= 3
+
+			if err := iprops.SetProp(v.global.Ctx, memberName, val); err != nil {
+				v.err = err
+				return
+			}
 		case OpSetIndex:
 			slice := v.stack[v.sp-3].(MutableSequence)
 			index := int(v.stack[v.sp-2].(Int))
@@ -2012,41 +2129,12 @@ func (v *VM) handleOtherOpcodes(op byte) (_continue bool) {
 		v.stack[v.sp-1] = val
 	case OpSpreadObjectPattern:
 		patt := v.stack[v.sp-2].(*ObjectPattern)
-		spreadObjectPatt := v.stack[v.sp-1].(*ObjectPattern)
-
-		for _, entry := range spreadObjectPatt.entries {
-			//priority to property pattern defined earlier.
-			if patt.HasRequiredOrOptionalEntry(entry.Name) {
-				//already present.
-				continue
-			}
-
-			patt.entries = append(patt.entries, ObjectPatternEntry{
-				Name:       entry.Name,
-				Pattern:    entry.Pattern,
-				IsOptional: entry.IsOptional,
-				//ignore dependencies.
-			})
-		}
+		patt.addSpreadEntries(v.stack[v.sp-1].(Pattern))
 		patt.init()
 		v.sp--
 	case OpSpreadRecordPattern:
 		patt := v.stack[v.sp-2].(*RecordPattern)
-		spreadRecordPatt := v.stack[v.sp-1].(*RecordPattern)
-
-		for _, entry := range spreadRecordPatt.entries {
-			//priority to property pattern defined earlier.
-			if patt.HasRequiredOrOptionalEntry(entry.Name) {
-				//already present.
-				continue
-			}
-
-			patt.entries = append(patt.entries, RecordPatternEntry{
-				Name:       entry.Name,
-				Pattern:    entry.Pattern,
-				IsOptional: entry.IsOptional,
-			})
-		}
+		patt.addSpreadEntries(v.stack[v.sp-1].(Pattern))
 		patt.init()
 		v.sp--
 	//MESSAGING
@@ -2271,6 +2359,23 @@ func (v *VM) handleOtherOpcodes(op byte) (_continue bool) {
 			step:         1,
 		}
 		v.sp--
+	case OpCreateSteppedRange:
+		lower := int64(v.stack[v.sp-3].(Int))
+		upper := int64(v.stack[v.sp-2].(Int))
+		step := int64(v.stack[v.sp-1].(Int))
+
+		if step <= 0 {
+			v.err = ErrNonPositiveIntRangeStep
+			return
+		}
+
+		v.stack[v.sp-3] = IntRange{
+			unknownStart: false,
+			start:        lower,
+			end:          upper,
+			step:         step,
+		}
+		v.sp -= 2
 	case OpCreateFloatRange:
 		lower := float64(v.stack[v.sp-2].(Float))
 		upper := float64(v.stack[v.sp-1].(Float))
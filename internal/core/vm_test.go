@@ -1,6 +1,7 @@
 package core
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -15,6 +16,139 @@ func TestVMStackOverflow(t *testing.T) {
 	`, nil, ErrStackOverflow)
 }
 
+func TestVMInstructionBudget(t *testing.T) {
+	bytecode, _, err := traceCompile(t, `
+		for i in 0.. {
+			a = i
+		}
+	`, nil)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ctx := NewContext(ContextConfig{})
+	defer ctx.CancelGracefully()
+
+	vm, err := NewVM(VMConfig{
+		Bytecode:        bytecode,
+		State:           NewGlobalState(ctx),
+		MaxInstructions: 1000,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = vm.Run()
+	if !assert.ErrorIs(t, err, ErrInstructionBudgetExceeded) {
+		return
+	}
+
+	//the error should include the position of the last dispatched instruction (inside the for statement).
+	var locatedErr LocatedEvalError
+	if assert.ErrorAs(t, err, &locatedErr) && assert.NotEmpty(t, locatedErr.Location) {
+		line := locatedErr.Location[0].StartLine
+		assert.True(t, line == 2 || line == 3, line)
+	}
+}
+
+func TestVMOpcodeCounters(t *testing.T) {
+	bytecode, _, err := traceCompile(t, `
+		a = 1
+		b = 2
+		(a + b)
+		return a
+	`, nil)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ctx := NewContext(ContextConfig{})
+	defer ctx.CancelGracefully()
+
+	counters := make([]uint64, len(OpcodeNames))
+
+	vm, err := NewVM(VMConfig{
+		Bytecode:       bytecode,
+		State:          NewGlobalState(ctx),
+		OpcodeCounters: counters,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = vm.Run()
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	assert.EqualValues(t, 2, counters[OpPushConstant])
+	assert.EqualValues(t, 1, counters[OpPop])
+	assert.EqualValues(t, 3, counters[OpGetLocal])
+
+	table := FormatOpcodeCounters(counters)
+	lines := strings.Split(strings.TrimSpace(table), "\n")
+
+	if !assert.Len(t, lines, 7) {
+		return
+	}
+	assert.Regexp(t, `^OPCODE\s+COUNT$`, lines[0])
+	assert.Regexp(t, `^GET_LOCAL\s+3$`, lines[1])
+	assert.Regexp(t, `^PUSH_CONST\s+2$`, lines[2])
+	assert.Regexp(t, `^SET_LOCAL\s+2$`, lines[3])
+}
+
+func TestVMExecutedIPsAndCoverage(t *testing.T) {
+	bytecode, _, err := traceCompile(t, `
+a = 1; b = 0
+if (a == 1) {
+	b = 2
+} else {
+	b = 3
+}
+return b`, nil)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ctx := NewContext(ContextConfig{})
+	defer ctx.CancelGracefully()
+
+	executedIPs := map[int]bool{}
+
+	vm, err := NewVM(VMConfig{
+		Bytecode:    bytecode,
+		State:       NewGlobalState(ctx),
+		ExecutedIPs: executedIPs,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	res, err := vm.Run()
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, Int(2), res)
+
+	coverage := bytecode.LineCoverage(executedIPs)
+	assert.True(t, coverage[2])  //a = 1; b = 0
+	assert.True(t, coverage[4])  //b = 2
+	assert.False(t, coverage[6]) //b = 3
+	assert.True(t, coverage[8])  //return b
+	assert.Contains(t, coverage, 6)
+
+	chunk := bytecode.module.MainChunk
+	var executedCode []string
+	for _, span := range bytecode.main.ExecutedSpans(executedIPs) {
+		executedCode = append(executedCode, string(chunk.Runes()[span.Start:span.End]))
+	}
+	assert.Contains(t, executedCode, "b = 2")
+	assert.NotContains(t, executedCode, "b = 3")
+}
+
 func expectError(t *testing.T, input string, globals map[Identifier]Value, target error) {
 	actual, _, e := traceCompile(t, input, nil)
 
@@ -33,3 +167,45 @@ func expectError(t *testing.T, input string, globals map[Identifier]Value, targe
 	_, err = vm.Run()
 	assert.ErrorIs(t, err, target)
 }
+
+func TestVMConstantIndexVerification(t *testing.T) {
+	bytecode, _, err := traceCompile(t, `
+		return 1
+	`, nil)
+
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	ctx := NewContext(ContextConfig{})
+	defer ctx.CancelGracefully()
+	state := NewGlobalState(ctx)
+
+	_, err = NewVM(VMConfig{
+		Bytecode:              bytecode,
+		State:                 state,
+		VerifyConstantIndices: true,
+	})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	//malformed bytecode: the instructions refer to a constant that does not exist.
+	malformed := &Bytecode{
+		module:    bytecode.module,
+		constants: bytecode.constants,
+		main: &CompiledFunction{
+			Instructions: append(
+				MakeInstruction(OpPushConstant, len(bytecode.constants)),
+				MakeInstruction(OpReturn, 1)...,
+			),
+		},
+	}
+
+	_, err = NewVM(VMConfig{
+		Bytecode:              malformed,
+		State:                 state,
+		VerifyConstantIndices: true,
+	})
+	assert.ErrorIs(t, err, ErrConstantIndexOutOfRange)
+}
@@ -22,6 +22,7 @@ const (
 
 	SERIALIZED_INT_RANGE_START_KEY     = "start"
 	SERIALIZED_INT_RANGE_START_END_KEY = "end"
+	SERIALIZED_INT_RANGE_STEP_KEY      = "step"
 
 	//float range serialization
 
@@ -1039,6 +1040,12 @@ func (r IntRange) WriteJSONRepresentation(ctx *Context, w *jsoniter.Stream, conf
 		w.WriteObjectField(SERIALIZED_INT_RANGE_START_END_KEY)
 		writeIntJsonRepr(Int(r.end), w)
 
+		if r.step > 1 {
+			w.WriteMore()
+			w.WriteObjectField(SERIALIZED_INT_RANGE_STEP_KEY)
+			writeIntJsonRepr(Int(r.step), w)
+		}
+
 		w.WriteObjectEnd()
 		return nil
 	}
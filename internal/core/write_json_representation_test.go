@@ -897,6 +897,18 @@ func TestIntRangeJSONRepresentation(t *testing.T) {
 		}))
 	})
 
+	t.Run("step", func(t *testing.T) {
+		ctx := NewContextWithEmptyState(ContextConfig{}, nil)
+		defer ctx.CancelGracefully()
+
+		intRange := NewSteppedIntRange(0, 100, 5)
+
+		assert.Equal(t, `{"int-range__value":{"start":0,"end":100,"step":5}}`, getJSONRepr(t, intRange, ctx))
+		assert.Equal(t, `{"start":0,"end":100,"step":5}`, getJSONRepr(t, intRange, ctx, JSONSerializationConfig{
+			Pattern: INT_RANGE_PATTERN,
+		}))
+	})
+
 }
 
 func TestFloatRangeJSONRepresentation(t *testing.T) {
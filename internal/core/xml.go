@@ -1,5 +1,11 @@
 package core
 
+import (
+	"slices"
+
+	"github.com/inoxlang/inox/internal/core/symbolic"
+)
+
 const DEFAULT_XML_ATTR_VALUE = String("")
 
 // A XMLElement represents the result of the evaluation of an XMLElement node in Inox code.
@@ -19,6 +25,22 @@ func (e *XMLElement) Children() []Value {
 	return e.children[0:len(e.children):len(e.children)]
 }
 
+func (e *XMLElement) Prop(ctx *Context, name string) Value {
+	switch name {
+	case "children":
+		return NewArrayFrom(slices.Clone(e.children)...)
+	}
+	panic(FormatErrPropertyDoesNotExist(name, e))
+}
+
+func (*XMLElement) SetProp(ctx *Context, name string, value Value) error {
+	return ErrCannotSetProp
+}
+
+func (*XMLElement) PropertyNames(ctx *Context) []string {
+	return symbolic.XML_ELEMENT_PROPNAMES
+}
+
 func (e *XMLElement) RawContent() string {
 	return e.rawContent
 }
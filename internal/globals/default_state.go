@@ -298,6 +298,10 @@ func NewDefaultGlobalState(ctx *core.Context, conf core.DefaultGlobalStateConfig
 		globalnames.FIND_FN:            core.WrapGoFunction(_find),
 		globalnames.FIND_FIRST_FN:      core.WrapGoFunction(_find_first),
 
+		// list
+		globalnames.REVERSE_FN: core.WrapGoFunction(core.Reverse),
+		globalnames.DEDUP_FN:   core.WrapGoFunction(core.Dedup),
+
 		// concurrency & execution
 		globalnames.LTHREADGROUP_FN: core.ValOf(core.NewLThreadGroup),
 		globalnames.RUN_FN:          core.ValOf(_run),
@@ -38,7 +38,7 @@ func Find(ctx *core.Context, dir core.Path, filters ...core.Pattern) (*core.List
 	for _, filter := range filters {
 		switch filt := filter.(type) {
 		case core.PathPattern:
-			matches, err := glob(fls, string(filt))
+			matches, err := glob(ctx, fls, string(filt))
 			if err != nil {
 				return nil, err
 			}
@@ -20,7 +20,7 @@ func Glob(ctx *core.Context, patt core.PathPattern) []core.Path {
 	fls := ctx.GetFileSystem()
 	absPtt := patt.ToAbs(fls)
 
-	res, err := glob(fls, string(absPtt))
+	res, err := glob(ctx, fls, string(absPtt))
 	if err != nil {
 		panic(err)
 	}
@@ -44,7 +44,7 @@ func Glob(ctx *core.Context, patt core.PathPattern) []core.Path {
 	return list
 }
 
-func glob(fls afs.Filesystem, absPattern string) (matches []string, e error) {
+func glob(ctx *core.Context, fls afs.Filesystem, absPattern string) (matches []string, e error) {
 	if absPattern[0] != '/' {
 		return nil, errors.New("only absolute pattern are supported")
 	}
@@ -96,6 +96,10 @@ func glob(fls afs.Filesystem, absPattern string) (matches []string, e error) {
 
 		var temp []Entry
 		for _, entry := range workingEntries {
+			if ctx.IsDoneSlowCheck() {
+				return nil, ctx.Err()
+			}
+
 			workingPath := entry.path
 			idx := entry.index
 			segment := segments[entry.index]
@@ -8,6 +8,9 @@ import (
 	"path"
 	"path/filepath"
 	"reflect"
+	"slices"
+	"sync"
+	"time"
 
 	"github.com/inoxlang/inox/internal/afs"
 	"github.com/inoxlang/inox/internal/commonfmt"
@@ -26,6 +29,9 @@ var (
 
 	LISTFILES_ARG_NAMES   = []string{"path-or-pattern"}
 	LISTFILES_SYMB_PARAMS = &[]symbolic.Value{symbolic.NewMultivalue(symbolic.ANY_PATH, symbolic.ANY_PATH_PATTERN)}
+
+	//if not nil listFilesGoroutineExitHook is called when a goroutine started by ListFiles exits, only set by tests.
+	listFilesGoroutineExitHook func()
 )
 
 // ReadFile expects a core.Path argument, it reads the whole content of a file.
@@ -156,7 +162,65 @@ func Read(ctx *core.Context, path core.Path, args ...core.Value) (result core.Va
 	}
 }
 
+// ListFiles lists the files of a directory or the files matching a path pattern. If the context has an operation timeout
+// (see core.Context.TimeoutForOperation) and the listing takes too long, the files listed so far are returned along
+// with core.ErrOperationTimeout.
 func ListFiles(ctx *core.Context, pathOrPatt *core.OptionalParam[core.Value]) ([]core.FileInfo, error) {
+	timeout, hasTimeout := ctx.TimeoutForOperation()
+	if !hasTimeout {
+		return listFiles(ctx, pathOrPatt, nil)
+	}
+
+	var (
+		lock   sync.Mutex
+		listed []core.FileInfo
+		done   = make(chan error, 1)
+	)
+
+	//the listing context is cancelled when ListFiles returns in order to stop the listing goroutine.
+	listingCtx := ctx.BoundChild()
+	defer listingCtx.CancelGracefully()
+
+	go func() {
+		defer func() {
+			if e := recover(); e != nil {
+				done <- utils.ConvertPanicValueToError(e)
+			}
+			if listFilesGoroutineExitHook != nil {
+				listFilesGoroutineExitHook()
+			}
+		}()
+
+		_, err := listFiles(listingCtx, pathOrPatt, func(info core.FileInfo) {
+			lock.Lock()
+			defer lock.Unlock()
+			listed = append(listed, info)
+		})
+		done <- err
+	}()
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+
+	select {
+	case err := <-done:
+		if err != nil {
+			return nil, err
+		}
+		lock.Lock()
+		defer lock.Unlock()
+		return listed, nil
+	case <-timer.C:
+		lock.Lock()
+		defer lock.Unlock()
+		return slices.Clone(listed), core.ErrOperationTimeout
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// listFiles implements ListFiles, onFile is called for each listed file if not nil.
+func listFiles(ctx *core.Context, pathOrPatt *core.OptionalParam[core.Value], onFile func(info core.FileInfo)) ([]core.FileInfo, error) {
 	fls := ctx.GetFileSystem()
 
 	var pth core.Path
@@ -197,13 +261,21 @@ func ListFiles(ctx *core.Context, pathOrPatt *core.OptionalParam[core.Value]) ([
 		}
 
 		for _, entry := range entries {
+			if ctx.IsDoneSlowCheck() {
+				return nil, ctx.Err()
+			}
+
 			fpath := path.Join(string(pth), entry.Name())
 			info, err := fls.Stat(fpath)
 			if err != nil {
 				return nil, err
 			}
 
-			resultFileInfo = append(resultFileInfo, makeFileInfo(info, fpath, fls))
+			fileInfo := makeFileInfo(info, fpath, fls)
+			resultFileInfo = append(resultFileInfo, fileInfo)
+			if onFile != nil {
+				onFile(fileInfo)
+			}
 		}
 	} else { //pattern
 		absPatt := patt.ToAbs(ctx.GetFileSystem())
@@ -216,19 +288,27 @@ func ListFiles(ctx *core.Context, pathOrPatt *core.OptionalParam[core.Value]) ([
 			return nil, err
 		}
 
-		matches, err := glob(fls, string(absPatt))
+		matches, err := glob(ctx, fls, string(absPatt))
 
 		if err != nil {
 			return nil, err
 		}
 
 		for _, match := range matches {
+			if ctx.IsDoneSlowCheck() {
+				return nil, ctx.Err()
+			}
+
 			info, err := fls.Stat(match)
 			if err != nil {
 				return nil, err
 			}
 
-			resultFileInfo = append(resultFileInfo, makeFileInfo(info, match, fls))
+			fileInfo := makeFileInfo(info, match, fls)
+			resultFileInfo = append(resultFileInfo, fileInfo)
+			if onFile != nil {
+				onFile(fileInfo)
+			}
 		}
 	}
 
@@ -4,9 +4,13 @@ import (
 	"os"
 	"path"
 	"path/filepath"
+	"slices"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/go-git/go-billy/v5/util"
+	"github.com/inoxlang/inox/internal/afs"
 	"github.com/inoxlang/inox/internal/core"
 	"github.com/inoxlang/inox/internal/core/permkind"
 	"github.com/inoxlang/inox/internal/utils"
@@ -185,3 +189,83 @@ func TestFind(t *testing.T) {
 	})
 
 }
+
+func TestListFiles(t *testing.T) {
+
+	t.Run("timeout", func(t *testing.T) {
+		fls := NewMemFilesystem(1_000_000)
+		util.WriteFile(fls, "/dir/file1.txt", nil, 0600)
+		util.WriteFile(fls, "/dir/file2.txt", nil, 0600)
+		util.WriteFile(fls, "/dir/file3.txt", nil, 0600)
+
+		blockingFls := &blockingStatFilesystem{
+			Filesystem:  fls,
+			blockedFile: "file2.txt",
+			release:     make(chan struct{}),
+		}
+
+		exited := make(chan struct{})
+		listFilesGoroutineExitHook = func() { close(exited) }
+		defer func() { listFilesGoroutineExitHook = nil }()
+
+		ctx := core.NewContext(core.ContextConfig{
+			Permissions: []core.Permission{
+				core.FilesystemPermission{Kind_: permkind.Read, Entity: core.PathPattern("/...")},
+			},
+			Filesystem:       blockingFls,
+			OperationTimeout: 100 * time.Millisecond,
+		})
+		core.NewGlobalState(ctx)
+		defer ctx.CancelGracefully()
+
+		files, err := ListFiles(ctx, core.ToValueOptionalParam(core.Path("/dir/")))
+		if !assert.ErrorIs(t, err, core.ErrOperationTimeout) {
+			return
+		}
+
+		//only the file listed before the timeout should be returned.
+		if assert.Len(t, files, 1) {
+			assert.Equal(t, core.Path("/dir/file1.txt"), files[0].AbsPath_)
+		}
+
+		//the listing goroutine should stop without listing the remaining files once the blocked call returns.
+		close(blockingFls.release)
+
+		select {
+		case <-exited:
+		case <-time.After(time.Second):
+			assert.FailNow(t, "the listing goroutine did not exit")
+		}
+
+		assert.NotContains(t, blockingFls.statedFiles(), "file3.txt")
+	})
+}
+
+// blockingStatFilesystem is a filesystem whose Stat method blocks for a given file until .release is closed.
+type blockingStatFilesystem struct {
+	afs.Filesystem
+	blockedFile string
+	release     chan struct{}
+
+	lock   sync.Mutex
+	stated []string
+}
+
+func (fls *blockingStatFilesystem) Stat(filename string) (os.FileInfo, error) {
+	name := filepath.Base(filename)
+
+	fls.lock.Lock()
+	fls.stated = append(fls.stated, name)
+	fls.lock.Unlock()
+
+	if name == fls.blockedFile {
+		<-fls.release
+	}
+	return fls.Filesystem.Stat(filename)
+}
+
+func (fls *blockingStatFilesystem) statedFiles() []string {
+	fls.lock.Lock()
+	defer fls.lock.Unlock()
+	return slices.Clone(fls.stated)
+}
@@ -115,6 +115,10 @@ const (
 	FIND_FN            = "find"
 	FIND_FIRST_FN      = "find_first"
 
+	// list
+	REVERSE_FN = "reverse"
+	DEDUP_FN   = "dedup"
+
 	// concurrency & execution
 	LTHREADGROUP_FN = "LThreadGroup"
 	RUN_FN          = "run"
@@ -82,6 +82,10 @@ func registerHelp() {
 		globalnames.RAND_FN:            _rand,
 		globalnames.FIND_FN:            _find,
 
+		//list
+		globalnames.REVERSE_FN: core.Reverse,
+		globalnames.DEDUP_FN:   core.Dedup,
+
 		// concurrency & execution
 		globalnames.LTHREADGROUP_FN: core.NewLThreadGroup,
 		globalnames.RUN_FN:          _run,
@@ -1583,6 +1583,7 @@ const (
 	SetDifference
 	NilCoalescing
 	PairComma
+	As
 )
 
 var BINARY_OPERATOR_STRINGS = [...]string{
@@ -1622,6 +1623,7 @@ var BINARY_OPERATOR_STRINGS = [...]string{
 	SetDifference:     "\\",
 	NilCoalescing:     "??",
 	PairComma:         ",",
+	As:                "as",
 }
 
 func (operator BinaryOperator) String() string {
@@ -1674,6 +1676,7 @@ type IntegerRangeLiteral struct {
 	NodeBase
 	LowerBound *IntLiteral
 	UpperBound Node //can be nil
+	Step       Node //can be nil
 }
 
 func (IntegerRangeLiteral) Kind() NodeKind {
@@ -2885,6 +2888,7 @@ func walk(node, parent Node, ancestorChain *[]Node, fn, afterFn NodeHandler) {
 	case *IntegerRangeLiteral:
 		walk(n.LowerBound, node, ancestorChain, fn, afterFn)
 		walk(n.UpperBound, node, ancestorChain, fn, afterFn)
+		walk(n.Step, node, ancestorChain, fn, afterFn)
 	case *FloatRangeLiteral:
 		walk(n.LowerBound, node, ancestorChain, fn, afterFn)
 		walk(n.UpperBound, node, ancestorChain, fn, afterFn)
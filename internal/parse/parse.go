@@ -4658,6 +4658,7 @@ func (p *parser) parseUnaryBinaryAndParenthesizedExpression(openingParenIndex in
 	const (
 		AND_LEN = int32(len("and"))
 		OR_LEN  = int32(len("or"))
+		AS_LEN  = int32(len("as"))
 	)
 
 	var (
@@ -4762,6 +4763,15 @@ _switch:
 			break
 		}
 
+		if p.len-p.i >= AS_LEN &&
+			string(p.s[p.i:p.i+AS_LEN]) == "as" &&
+			(p.len-p.i == AS_LEN || !IsIdentChar(p.s[p.i+AS_LEN])) {
+			operator = As
+			p.i += AS_LEN
+			operatorType = AS_KEYWORD
+			break
+		}
+
 		eatInvalidOperatorToken(operatorStart)
 		parsingErr = makeInvalidOperatorError()
 	case 'i':
@@ -4934,7 +4944,7 @@ _switch:
 	inPatternSave := p.inPattern
 
 	switch operator {
-	case Match, NotMatch:
+	case Match, NotMatch, As:
 		p.inPattern = true
 	}
 
@@ -5735,14 +5745,51 @@ func (p *parser) parseNumberAndNumberRange() Node {
 				parsingError = &ParsingError{UnspecifiedParsingError, UPPER_BOUND_OF_INT_RANGE_LIT_SHOULD_BE_INT_LIT}
 			}
 
+			end := upperBound.Base().Span.End
+
+			//optional step: <lower>..<upper> step <int literal>
+			var step Node
+
+			const stepKeywordLen = int32(len(STEP_KEYWORD_STRING))
+
+			tempIndex := p.i
+			for tempIndex < p.len && isSpaceNotLF(p.s[tempIndex]) {
+				tempIndex++
+			}
+
+			if tempIndex > p.i && tempIndex+stepKeywordLen < p.len &&
+				string(p.s[tempIndex:tempIndex+stepKeywordLen]) == STEP_KEYWORD_STRING &&
+				isSpaceNotLF(p.s[tempIndex+stepKeywordLen]) {
+
+				p.i = tempIndex
+				p.tokens = append(p.tokens, Token{Type: STEP_KEYWORD, Span: NodeSpan{p.i, p.i + stepKeywordLen}})
+				p.i += stepKeywordLen
+				p.eatSpace()
+
+				step, isMissingExpr = p.parseExpression()
+				end = p.i
+
+				if isMissingExpr {
+					if parsingError == nil {
+						parsingError = &ParsingError{UnspecifiedParsingError, UNTERMINATED_INT_RANGE_LIT_MISSING_STEP}
+					}
+				} else {
+					end = step.Base().Span.End
+					if lit, ok := step.(*IntLiteral); (!ok || lit.Value <= 0) && parsingError == nil {
+						parsingError = &ParsingError{UnspecifiedParsingError, STEP_OF_INT_RANGE_LIT_SHOULD_BE_POSITIVE_INT_LIT}
+					}
+				}
+			}
+
 			return &IntegerRangeLiteral{
 				NodeBase: NodeBase{
-					NodeSpan{lowerIntLiteral.Base().Span.Start, upperBound.Base().Span.End},
+					NodeSpan{lowerIntLiteral.Base().Span.Start, end},
 					parsingError,
 					false,
 				},
 				LowerBound: lowerIntLiteral,
 				UpperBound: upperBound,
+				Step:       step,
 			}
 		}
 
@@ -10228,7 +10275,7 @@ func (p *parser) parseAssignment(left Node) (result Node) {
 	var keywordLHSError *ParsingError
 
 	switch l := left.(type) {
-	case *GlobalVariable, *Variable, *MemberExpression, *IndexExpression, *SliceExpression, *IdentifierMemberExpression:
+	case *GlobalVariable, *Variable, *MemberExpression, *ComputedMemberExpression, *IndexExpression, *SliceExpression, *IdentifierMemberExpression:
 	case *IdentifierLiteral:
 		if isKeyword(l.Name) {
 			keywordLHSError = &ParsingError{UnspecifiedParsingError, KEYWORDS_SHOULD_NOT_BE_USED_IN_ASSIGNMENT_LHS}
@@ -377,6 +377,8 @@ const (
 	INVALID_INT_LIT                                    = "invalid integer literal"
 	UNTERMINATED_INT_RANGE_LIT                         = "unterminated integer range literal"
 	UPPER_BOUND_OF_INT_RANGE_LIT_SHOULD_BE_INT_LIT     = "upper bound of an integer range literal should be a integer literal"
+	UNTERMINATED_INT_RANGE_LIT_MISSING_STEP            = "unterminated integer range literal: missing step after 'step' keyword"
+	STEP_OF_INT_RANGE_LIT_SHOULD_BE_POSITIVE_INT_LIT   = "step of an integer range literal should be a positive integer literal"
 	UPPER_BOUND_OF_FLOAT_RANGE_LIT_SHOULD_BE_FLOAT_LIT = "upper bound of a float range literal should be a float literal"
 
 	UNTERMINATED_QTY_RANGE_LIT                     = "unterminated quantity range literal"
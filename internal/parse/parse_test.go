@@ -8716,6 +8716,39 @@ func testParse(
 			}, n)
 		})
 
+		t.Run("<computed member expr> = <value>", func(t *testing.T) {
+			n := mustparseChunk(t, "$a.(b) = 1")
+			assert.EqualValues(t, &Chunk{
+				NodeBase: NodeBase{NodeSpan{0, 10}, nil, false},
+				Statements: []Node{
+					&Assignment{
+						NodeBase: NodeBase{
+							NodeSpan{0, 10},
+							nil,
+							false,
+						},
+						Left: &ComputedMemberExpression{
+							NodeBase: NodeBase{NodeSpan{0, 6}, nil, false},
+							Left: &Variable{
+								NodeBase: NodeBase{NodeSpan{0, 2}, nil, false},
+								Name:     "a",
+							},
+							PropertyName: &IdentifierLiteral{
+								NodeBase: NodeBase{NodeSpan{4, 5}, nil, true},
+								Name:     "b",
+							},
+						},
+						Right: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{9, 10}, nil, false},
+							Raw:      "1",
+							Value:    1,
+						},
+						Operator: Assign,
+					},
+				},
+			}, n)
+		})
+
 		t.Run("var = new <type>", func(t *testing.T) {
 			n := mustparseChunk(t, "$a = new Lexer")
 			assert.EqualValues(t, &Chunk{
@@ -16486,6 +16519,59 @@ func testParse(
 			}, n)
 		})
 
+		t.Run("as", func(t *testing.T) {
+			n := mustparseChunk(t, "(o as %int)")
+			assert.EqualValues(t, &Chunk{
+				NodeBase: NodeBase{NodeSpan{0, 11}, nil, false},
+				Statements: []Node{
+					&BinaryExpression{
+						NodeBase: NodeBase{
+							NodeSpan{0, 11},
+							nil,
+							true,
+						},
+						Operator: As,
+						Left: &IdentifierLiteral{
+							NodeBase: NodeBase{NodeSpan{1, 2}, nil, false},
+							Name:     "o",
+						},
+						Right: &PatternIdentifierLiteral{
+							NodeBase: NodeBase{NodeSpan{6, 10}, nil, false},
+							Name:     "int",
+						},
+					},
+				},
+			}, n)
+		})
+
+		t.Run("as with unprefixed pattern", func(t *testing.T) {
+			n := mustparseChunk(t, "(o as {})")
+			assert.EqualValues(t, &Chunk{
+				NodeBase: NodeBase{NodeSpan{0, 9}, nil, false},
+				Statements: []Node{
+					&BinaryExpression{
+						NodeBase: NodeBase{
+							NodeSpan{0, 9},
+							nil,
+							true,
+						},
+						Operator: As,
+						Left: &IdentifierLiteral{
+							NodeBase: NodeBase{NodeSpan{1, 2}, nil, false},
+							Name:     "o",
+						},
+						Right: &ObjectPatternLiteral{
+							NodeBase: NodeBase{
+								NodeSpan{6, 8},
+								nil,
+								false,
+							},
+						},
+					},
+				},
+			}, n)
+		})
+
 		t.Run("range", func(t *testing.T) {
 			n := mustparseChunk(t, "($a .. $b)")
 			assert.EqualValues(t, &Chunk{
@@ -17106,6 +17192,99 @@ func testParse(
 				},
 			}, n)
 		})
+
+		t.Run("step", func(t *testing.T) {
+			n := mustparseChunk(t, "0..10 step 2")
+			assert.EqualValues(t, &Chunk{
+				NodeBase: NodeBase{NodeSpan{0, 12}, nil, false},
+				Statements: []Node{
+					&IntegerRangeLiteral{
+						NodeBase: NodeBase{NodeSpan{0, 12}, nil, false},
+						LowerBound: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{0, 1}, nil, false},
+							Raw:      "0",
+							Value:    0,
+						},
+						UpperBound: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{3, 5}, nil, false},
+							Raw:      "10",
+							Value:    10,
+						},
+						Step: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{11, 12}, nil, false},
+							Raw:      "2",
+							Value:    2,
+						},
+					},
+				},
+			}, n)
+		})
+
+		t.Run("missing step after 'step' keyword", func(t *testing.T) {
+			n, err := parseChunk(t, "0..10 step ", "")
+			assert.Error(t, err)
+			assert.EqualValues(t, &Chunk{
+				NodeBase: NodeBase{NodeSpan{0, 11}, nil, false},
+				Statements: []Node{
+					&IntegerRangeLiteral{
+						NodeBase: NodeBase{
+							NodeSpan{0, 11},
+							&ParsingError{UnspecifiedParsingError, UNTERMINATED_INT_RANGE_LIT_MISSING_STEP},
+							false,
+						},
+						LowerBound: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{0, 1}, nil, false},
+							Raw:      "0",
+							Value:    0,
+						},
+						UpperBound: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{3, 5}, nil, false},
+							Raw:      "10",
+							Value:    10,
+						},
+						Step: &MissingExpression{
+							NodeBase: NodeBase{
+								NodeSpan{10, 11},
+								&ParsingError{UnspecifiedParsingError, fmtExprExpectedHere([]rune("0..10 step "), 11, true)},
+								false,
+							},
+						},
+					},
+				},
+			}, n)
+		})
+
+		t.Run("step should be a positive integer", func(t *testing.T) {
+			n, err := parseChunk(t, "0..10 step 0", "")
+			assert.Error(t, err)
+			assert.EqualValues(t, &Chunk{
+				NodeBase: NodeBase{NodeSpan{0, 12}, nil, false},
+				Statements: []Node{
+					&IntegerRangeLiteral{
+						NodeBase: NodeBase{
+							NodeSpan{0, 12},
+							&ParsingError{UnspecifiedParsingError, STEP_OF_INT_RANGE_LIT_SHOULD_BE_POSITIVE_INT_LIT},
+							false,
+						},
+						LowerBound: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{0, 1}, nil, false},
+							Raw:      "0",
+							Value:    0,
+						},
+						UpperBound: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{3, 5}, nil, false},
+							Raw:      "10",
+							Value:    10,
+						},
+						Step: &IntLiteral{
+							NodeBase: NodeBase{NodeSpan{11, 12}, nil, false},
+							Raw:      "0",
+							Value:    0,
+						},
+					},
+				},
+			}, n)
+		})
 	})
 
 	t.Run("float range literal", func(t *testing.T) {
@@ -19,6 +19,7 @@ const (
 	IF_KEYWORD_STRING               = "if"
 	STRUCT_KEYWORD_STRING           = "struct"
 	NEW_KEYWORD_STRING              = "new"
+	STEP_KEYWORD_STRING             = "step"
 	INCLUDABLE_CHUNK_KEYWORD_STRING = "includable-file"
 )
 
@@ -73,6 +74,7 @@ const (
 	KEYOF_KEYWORD
 	URLOF_KEYWORD
 	SUBSTROF_KEYWORD
+	AS_KEYWORD
 	NOT_MATCH_KEYWORD
 	GO_KEYWORD
 	IMPORT_KEYWORD
@@ -107,6 +109,7 @@ const (
 	STRUCT_KEYWORD
 	NEW_KEYWORD
 	TO_KEYWORD
+	STEP_KEYWORD
 	OTHERPROPS_KEYWORD
 	AND_KEYWORD
 	OR_KEYWORD
@@ -349,6 +352,7 @@ var tokenStrings = [...]string{
 	STRUCT_KEYWORD:                 STRUCT_KEYWORD_STRING,
 	NEW_KEYWORD:                    NEW_KEYWORD_STRING,
 	TO_KEYWORD:                     "to",
+	STEP_KEYWORD:                   STEP_KEYWORD_STRING,
 	OTHERPROPS_KEYWORD:             OTHERPROPS_KEYWORD_STRING,
 	AND_KEYWORD:                    "and",
 	OR_KEYWORD:                     "or",
@@ -367,6 +371,7 @@ var tokenStrings = [...]string{
 	URLOF_KEYWORD:                  "urlof",
 	NOT_MATCH_KEYWORD:              "not-match",
 	SUBSTROF_KEYWORD:               "substrof",
+	AS_KEYWORD:                     "as",
 	SELF_CLOSING_TAG_TERMINATOR:    "/>",
 	END_TAG_OPEN_DELIMITER:         "</",
 	OPENING_BRACKET:                "[",
@@ -488,6 +493,7 @@ var tokenTypenames = [...]string{
 	URLOF_KEYWORD:                  "URLOF_KEYWORD",
 	NOT_MATCH_KEYWORD:              "NOT_MATCH_KEYWORD",
 	SUBSTROF_KEYWORD:               "SUBSTROF_KEYWORD",
+	AS_KEYWORD:                     "AS_KEYWORD",
 	GO_KEYWORD:                     "GO_KEYWORD",
 	IMPORT_KEYWORD:                 "IMPORT_KEYWORD",
 	FN_KEYWORD:                     "FN_KEYWORD",
@@ -521,6 +527,7 @@ var tokenTypenames = [...]string{
 	STRUCT_KEYWORD:                 "STRUCT_KEYWORD",
 	NEW_KEYWORD:                    "NEW_KEYWORD",
 	TO_KEYWORD:                     "TO_KEYWORD",
+	STEP_KEYWORD:                   "STEP_KEYWORD",
 	OTHERPROPS_KEYWORD:             "OTHERPROPS_KEYWORD",
 	AND_KEYWORD:                    "AND_KEYWORD",
 	OR_KEYWORD:                     "OR_KEYWORD",